@@ -21,6 +21,7 @@ var (
 )
 
 func Close() {
+	Mempool.Close()
 	store.DBClose()
 	gui.GUI.Close()
 	Forging.Close()
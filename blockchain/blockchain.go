@@ -19,6 +19,7 @@ import (
 	"pandora-pay/blockchain/transactions/transaction/transaction_zether/transaction_zether_payload/transaction_zether_payload_extra"
 	"pandora-pay/blockchain/transactions/transaction/transaction_zether/transaction_zether_payload/transaction_zether_payload_script"
 	"pandora-pay/config"
+	"pandora-pay/config/arguments"
 	"pandora-pay/config/config_coins"
 	"pandora-pay/config/config_stake"
 	"pandora-pay/gui"
@@ -28,6 +29,7 @@ import (
 	"pandora-pay/mempool"
 	"pandora-pay/network/websocks/connection/advanced_connection_types"
 	"pandora-pay/store"
+	"pandora-pay/store/state_tree"
 	"pandora-pay/store/store_db/store_db_interface"
 	"pandora-pay/txs_validator"
 	"pandora-pay/wallet"
@@ -98,6 +100,7 @@ func (chain *Blockchain) AddBlocks(blocksComplete []*block_complete.BlockComplet
 		helpers.CloneBytes(chainData.PrevHash),         //atomic copy
 		helpers.CloneBytes(chainData.KernelHash),       //atomic copy
 		helpers.CloneBytes(chainData.PrevKernelHash),   //atomic copy
+		helpers.CloneBytes(chainData.StateHash),        //atomic copy
 		chainData.Height,                               //atomic copy
 		chainData.Timestamp,                            //atomic copy
 		new(big.Int).Set(chainData.Target),             //atomic copy
@@ -303,6 +306,10 @@ func (chain *Blockchain) AddBlocks(blocksComplete []*block_complete.BlockComplet
 						return errors.New("PrevHash doesn't match Genesis prevKernelHash")
 					}
 
+					if !bytes.Equal(blkComplete.Block.PrevStateHash, state_tree.NewStateTree(writer).Root()) {
+						return errors.New("PrevStateHash doesn't match the state root")
+					}
+
 					if blkComplete.Block.Timestamp < newChainData.Timestamp {
 						return errors.New("Timestamp has to be greater than the last timestmap")
 					}
@@ -339,6 +346,7 @@ func (chain *Blockchain) AddBlocks(blocksComplete []*block_complete.BlockComplet
 					newChainData.PrevKernelHash = newChainData.KernelHash
 					newChainData.KernelHash = blkComplete.Block.Bloom.KernelHash
 					newChainData.Timestamp = blkComplete.Block.Timestamp
+					newChainData.StateHash = state_tree.NewStateTree(writer).Root()
 
 					difficultyBigInt := difficulty.ConvertTargetToDifficulty(newChainData.Target)
 					newChainData.BigTotalDifficulty = new(big.Int).Add(newChainData.BigTotalDifficulty, difficultyBigInt)
@@ -407,6 +415,10 @@ func (chain *Blockchain) AddBlocks(blocksComplete []*block_complete.BlockComplet
 					}
 				}
 
+				if err = chain.pruneBlocksComplete(writer, newChainData.Height, newChainData.ConsecutiveSelfForged, dataStorage); err != nil {
+					panic(err)
+				}
+
 				//let's keep the order as well
 				var removedCount, insertedCount int
 				for _, change := range allTransactionsChanges {
@@ -519,11 +531,30 @@ func CreateBlockchain(mempool *mempool.Mempool) (*Blockchain, error) {
 	chain.updatesQueue.processBlockchainUpdateMempool()
 	chain.updatesQueue.processBlockchainUpdateNotifications()
 
+	chain.initCLI()
+
 	return chain, nil
 }
 
 func (chain *Blockchain) InitializeChain() (err error) {
 
+	if err = recoverSnapshotImport(); err != nil {
+		return
+	}
+
+	if arguments.Arguments["--import-snapshot"] != nil {
+		if arguments.Arguments["--import-snapshot-hash"] == nil {
+			return errors.New("--import-snapshot requires --import-snapshot-hash")
+		}
+		var trustedBlockHash []byte
+		if trustedBlockHash, err = base64.StdEncoding.DecodeString(arguments.Arguments["--import-snapshot-hash"].(string)); err != nil {
+			return
+		}
+		if err = chain.importSnapshot(arguments.Arguments["--import-snapshot"].(string), trustedBlockHash); err != nil {
+			return
+		}
+	}
+
 	if err = chain.loadBlockchain(); err != nil {
 		if err.Error() != "Chain not found" {
 			return
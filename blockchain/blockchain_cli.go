@@ -0,0 +1,38 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"pandora-pay/gui"
+)
+
+func (chain *Blockchain) initCLI() {
+
+	cliExportSnapshot := func(cmd string, ctx context.Context) (err error) {
+
+		chainHeight := chain.GetChainData().Height
+		if chainHeight < 2 {
+			return errors.New("Chain is too short to export a snapshot")
+		}
+
+		height := gui.GUI.OutputReadUint64("Snapshot Height. Leave empty for the last block", true, chainHeight-1, func(value uint64) bool {
+			return value > 0 && value < chainHeight
+		})
+		filename := gui.GUI.OutputReadFilename("Path to export Snapshot", "snapshot", false)
+
+		chainData, blockHash, commitment, err := chain.ExportSnapshot(filename, height)
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Snapshot exported at height %d to %s", chainData.Height, filename))
+		gui.GUI.OutputWrite(fmt.Sprintf("Snapshot block hash: %s", base64.StdEncoding.EncodeToString(blockHash)))
+		gui.GUI.OutputWrite(fmt.Sprintf("Snapshot commitment: %s", base64.StdEncoding.EncodeToString(commitment)))
+		gui.GUI.OutputWrite(fmt.Sprintf("Snapshot state hash: %s", base64.StdEncoding.EncodeToString(chainData.StateHash)))
+		return
+	}
+
+	gui.GUI.CommandDefineCallback("Export Snapshot", cliExportSnapshot, true)
+}
@@ -16,6 +16,7 @@ type BlockchainData struct {
 	PrevHash              []byte   `json:"prevHash" msgpack:"prevHash"`             //32
 	KernelHash            []byte   `json:"kernelHash" msgpack:"kernelHash"`         //32
 	PrevKernelHash        []byte   `json:"prevKernelHash" msgpack:"prevKernelHash"` //32
+	StateHash             []byte   `json:"stateHash" msgpack:"stateHash"`           //32 state root after the last block
 	Height                uint64   `json:"height" msgpack:"height"`
 	Timestamp             uint64   `json:"timestamp" msgpack:"timestamp"`
 	Target                *big.Int `json:"target" msgpack:"target"`
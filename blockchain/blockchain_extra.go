@@ -26,6 +26,7 @@ import (
 	"pandora-pay/helpers/recovery"
 	"pandora-pay/network/websocks/connection/advanced_connection_types"
 	"pandora-pay/store"
+	"pandora-pay/store/state_tree"
 	"pandora-pay/store/store_db/store_db_interface"
 )
 
@@ -43,6 +44,7 @@ func (chain *Blockchain) createGenesisBlockchainData() *BlockchainData {
 		helpers.CloneBytes(genesis.GenesisData.Hash),
 		helpers.CloneBytes(genesis.GenesisData.KernelHash),
 		helpers.CloneBytes(genesis.GenesisData.KernelHash),
+		nil,
 		0,
 		0,
 		new(big.Int).SetBytes(helpers.CloneBytes(genesis.GenesisData.Target)),
@@ -109,6 +111,8 @@ func (chain *Blockchain) initializeNewChain(chainData *BlockchainData, dataStora
 		false,
 		false,
 		false,
+		false,
+		false,
 		byte(config_coins.DECIMAL_SEPARATOR),
 		config_coins.MAX_SUPPLY_COINS_UNITS,
 		supply,
@@ -131,6 +135,7 @@ func (chain *Blockchain) initializeNewChain(chainData *BlockchainData, dataStora
 
 	chainData.AssetsCount = dataStorage.Asts.Count
 	chainData.AccountsCount = dataStorage.Regs.Count + dataStorage.PlainAccs.Count
+	chainData.StateHash = state_tree.NewStateTree(dataStorage.DBTx).Root()
 
 	return
 }
@@ -192,7 +197,7 @@ func (chain *Blockchain) createNextBlockForForging(chainData *BlockchainData, ne
 		var blk *block.Block
 		var err error
 		if chainData.Height == 0 {
-			if blk, err = genesis.CreateNewGenesisBlock(); err != nil {
+			if blk, err = genesis.CreateNewGenesisBlock(chainData.StateHash); err != nil {
 				gui.GUI.Error("Error creating next block", err)
 				return
 			}
@@ -203,6 +208,7 @@ func (chain *Blockchain) createNextBlockForForging(chainData *BlockchainData, ne
 					Height:  chainData.Height,
 				},
 				MerkleHash:     cryptography.SHA3([]byte{}),
+				PrevStateHash:  chainData.StateHash,
 				PrevHash:       chainData.Hash,
 				PrevKernelHash: chainData.KernelHash,
 				Timestamp:      chainData.Timestamp,
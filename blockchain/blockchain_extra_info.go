@@ -89,6 +89,8 @@ func saveAssetsInfo(asts *assets.Assets) (err error) {
 				v.Element.Identification,
 				v.Element.DecimalSeparator,
 				v.Element.Description[:generics.Min(100, len(v.Element.Description))],
+				v.Element.Paused,
+				v.Element.Frozen,
 				[]byte(k),
 			}
 			var data []byte
@@ -0,0 +1,125 @@
+package blockchain
+
+import (
+	"errors"
+	"math/big"
+	"pandora-pay/blockchain/blocks/block"
+	"pandora-pay/blockchain/blocks/block/difficulty"
+	"pandora-pay/blockchain/genesis"
+	"pandora-pay/config"
+	"pandora-pay/helpers"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+)
+
+type headersDifficultyEntry struct {
+	bigTotalDifficulty *big.Int
+	timestamp          uint64
+}
+
+// HeadersDifficulty replays the difficulty adjustment of the blockchain over the headers of a fork, so the kernel hashes are verified before the blocks are downloaded
+type HeadersDifficulty struct {
+	Target  *big.Int
+	height  uint64
+	entries map[uint64]*headersDifficultyEntry //total difficulty and timestamp by chain height
+}
+
+func (headersDifficulty *HeadersDifficulty) Clone() *HeadersDifficulty {
+	entries := make(map[uint64]*headersDifficultyEntry, len(headersDifficulty.entries))
+	for height, entry := range headersDifficulty.entries {
+		entries[height] = entry
+	}
+	return &HeadersDifficulty{headersDifficulty.Target, headersDifficulty.height, entries}
+}
+
+// GetBigTotalDifficulty returns the total difficulty of the chain after the last added header
+func (headersDifficulty *HeadersDifficulty) GetBigTotalDifficulty() *big.Int {
+	return headersDifficulty.entries[headersDifficulty.height].bigTotalDifficulty
+}
+
+// Add verifies the kernel hash of the next header against the target and computes the target of the following header the same way the blockchain does
+func (headersDifficulty *HeadersDifficulty) Add(blk *block.Block) (err error) {
+
+	if blk.Height != headersDifficulty.height {
+		return errors.New("Header height is invalid")
+	}
+
+	if !difficulty.CheckKernelHashBig(blk.Bloom.KernelHashStaked, headersDifficulty.Target) {
+		return errors.New("Header KernelHash Difficulty is not met")
+	}
+
+	last := headersDifficulty.entries[headersDifficulty.height]
+	if last == nil {
+		return errors.New("Header difficulty is missing")
+	}
+
+	entry := &headersDifficultyEntry{
+		new(big.Int).Add(last.bigTotalDifficulty, difficulty.ConvertTargetToDifficulty(headersDifficulty.Target)),
+		blk.Timestamp,
+	}
+
+	target := headersDifficulty.Target
+	if config.DIFFICULTY_BLOCK_WINDOW <= headersDifficulty.height {
+
+		first := headersDifficulty.entries[headersDifficulty.height-config.DIFFICULTY_BLOCK_WINDOW+1]
+		if first == nil {
+			return errors.New("Header difficulty is missing")
+		}
+
+		deltaTotalDifficulty := new(big.Int).Sub(entry.bigTotalDifficulty, first.bigTotalDifficulty)
+		if deltaTotalDifficulty.Cmp(config.BIG_INT_ZERO) == 0 {
+			return errors.New("Delta Difficulty is zero")
+		}
+
+		if target, err = difficulty.NextTargetBig(deltaTotalDifficulty, entry.timestamp-first.timestamp); err != nil {
+			return
+		}
+	}
+
+	headersDifficulty.Target = target
+	headersDifficulty.height += 1
+	headersDifficulty.entries[headersDifficulty.height] = entry
+	if headersDifficulty.height > config.DIFFICULTY_BLOCK_WINDOW {
+		delete(headersDifficulty.entries, headersDifficulty.height-config.DIFFICULTY_BLOCK_WINDOW)
+	}
+
+	return
+}
+
+// OpenLoadHeadersDifficulty loads the target and the difficulty window of the chain before the block at height
+func (chain *Blockchain) OpenLoadHeadersDifficulty(height uint64) (headersDifficulty *HeadersDifficulty, errFinal error) {
+
+	if height == 0 {
+		return &HeadersDifficulty{
+			new(big.Int).SetBytes(helpers.CloneBytes(genesis.GenesisData.Target)),
+			0,
+			map[uint64]*headersDifficultyEntry{0: {new(big.Int), 0}},
+		}, nil
+	}
+
+	errFinal = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		chainData := &BlockchainData{}
+		if err = chainData.loadBlockchainInfo(reader, height); err != nil {
+			return
+		}
+
+		headersDifficulty = &HeadersDifficulty{chainData.Target, height, make(map[uint64]*headersDifficultyEntry)}
+
+		first := uint64(1)
+		if height > config.DIFFICULTY_BLOCK_WINDOW {
+			first = height - config.DIFFICULTY_BLOCK_WINDOW + 1
+		}
+
+		for i := first; i <= height; i++ {
+			entry := &headersDifficultyEntry{}
+			if entry.bigTotalDifficulty, entry.timestamp, err = chainData.LoadTotalDifficultyExtra(reader, i); err != nil {
+				return
+			}
+			headersDifficulty.entries[i] = entry
+		}
+
+		return
+	})
+	return
+}
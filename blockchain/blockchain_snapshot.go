@@ -0,0 +1,731 @@
+package blockchain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/vmihailenco/msgpack/v5"
+	"golang.org/x/crypto/sha3"
+	"hash"
+	"io"
+	"os"
+	"pandora-pay/blockchain/blocks/block"
+	"pandora-pay/blockchain/data_storage"
+	"pandora-pay/blockchain/data_storage/accounts"
+	"pandora-pay/blockchain/data_storage/assets/asset"
+	"pandora-pay/blockchain/data_storage/conditional_payments_list"
+	"pandora-pay/blockchain/data_storage/plain_accounts/plain_account"
+	"pandora-pay/blockchain/data_storage/plain_accounts/plain_account/asset_fee_liquidity"
+	"pandora-pay/blockchain/genesis"
+	"pandora-pay/config"
+	"pandora-pay/config/config_coins"
+	"pandora-pay/cryptography"
+	"pandora-pay/cryptography/merkle_tree"
+	"pandora-pay/gui"
+	"pandora-pay/helpers"
+	"pandora-pay/helpers/advanced_buffers"
+	"pandora-pay/helpers/generics"
+	"pandora-pay/store"
+	"pandora-pay/store/hash_map"
+	"pandora-pay/store/state_tree"
+	"pandora-pay/store/store_db/store_db_interface"
+	"strconv"
+	"strings"
+)
+
+const (
+	snapshotVersion        uint64 = 2
+	snapshotImportBatch           = 10000
+	snapshotKeyMaxLength          = 1024
+	snapshotValueMaxLength        = 64 * 1024 * 1024
+	snapshotImportingKey          = "snapshotImporting"
+)
+
+var errSnapshotRollback = errors.New("Snapshot rollback")
+
+// snapshotKeyHashMap returns the hashmap name and the element key of a data storage element. Only the elements are exported, their indexes are rebuilt by the importer
+// pending stakes and conditional payments are not authenticated by the state tree
+func snapshotKeyHashMap(key string) (name, mapKey string, ok bool) {
+
+	switch {
+	case strings.HasPrefix(key, "accounts_"):
+		if len(key) < len("accounts_")+config_coins.ASSET_LENGTH {
+			return
+		}
+		name = key[:len("accounts_")+config_coins.ASSET_LENGTH]
+	case strings.HasPrefix(key, "conditionalPayments_"):
+		index := strings.IndexByte(key, ':')
+		if index < 0 {
+			return
+		}
+		name = key[:index]
+		blockHeight, err := strconv.ParseUint(name[len("conditionalPayments_"):], 10, 64)
+		if err != nil || strconv.FormatUint(blockHeight, 10) != name[len("conditionalPayments_"):] {
+			return
+		}
+	default:
+		index := strings.IndexByte(key, ':')
+		if index < 0 {
+			return
+		}
+		name = key[:index]
+		if name != "registrations" && name != "plainAccs" && name != "assets" && name != "pendingStakes" {
+			return
+		}
+	}
+
+	if !strings.HasPrefix(key[len(name):], ":map:") {
+		return
+	}
+	return name, key[len(name)+len(":map:"):], true
+}
+
+// the blocks and their txs hashes are exported to rebuild the chain info, everything else is rebuilt from them and from the data storage elements
+func isSnapshotKeyIncluded(key string) bool {
+	if strings.HasPrefix(key, "block_ByHash") || strings.HasPrefix(key, "blockTxs") {
+		return true
+	}
+	_, _, ok := snapshotKeyHashMap(key)
+	return ok
+}
+
+// snapshotWriter streams the snapshot to the file and hashes it at the same time
+type snapshotWriter struct {
+	w   *bufio.Writer
+	h   hash.Hash
+	err error
+}
+
+func (sw *snapshotWriter) write(data []byte) {
+	if sw.err != nil {
+		return
+	}
+	if _, sw.err = sw.w.Write(data); sw.err == nil {
+		sw.h.Write(data)
+	}
+}
+
+type snapshotReader struct {
+	r *bufio.Reader
+	h hash.Hash
+}
+
+func (sr *snapshotReader) ReadByte() (byte, error) {
+	b, err := sr.r.ReadByte()
+	if err == nil {
+		sr.h.Write([]byte{b})
+	}
+	return b, err
+}
+
+func (sr *snapshotReader) readUvarint() (uint64, error) {
+	return binary.ReadUvarint(sr)
+}
+
+func (sr *snapshotReader) readBytes(count uint64) ([]byte, error) {
+	out := make([]byte, count)
+	if _, err := io.ReadFull(sr.r, out); err != nil {
+		return nil, err
+	}
+	sr.h.Write(out)
+	return out, nil
+}
+
+func (sr *snapshotReader) readVariableBytes(limit uint64) ([]byte, error) {
+	n, err := sr.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > limit {
+		return nil, errors.New("Snapshot value exceeds the limit")
+	}
+	return sr.readBytes(n)
+}
+
+// ExportSnapshot writes the state at the given height into a single file. The state at height h is the state after the block h-1 and is committed by the PrevStateHash of the block h, which is included in the snapshot.
+// The blocks above the height are rolled back in a transaction that is never committed. The last 32 bytes of the file are the SHA3 commitment of the content
+func (chain *Blockchain) ExportSnapshot(path string, height uint64) (chainData *BlockchainData, blockHash, commitment []byte, err error) {
+
+	chain.mutex.Lock()
+	defer chain.mutex.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return
+	}
+	defer func() {
+		f.Close()
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+
+	sw := &snapshotWriter{w: bufio.NewWriter(f), h: sha3.New256()}
+
+	var errExport error
+	errExport = errSnapshotRollback
+
+	if err = store.StoreBlockchain.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) error {
+
+		errExport = func() (err error) {
+
+			chainInfoData := writer.Get("blockchainInfo")
+			if chainInfoData == nil {
+				return errors.New("Chain not found")
+			}
+
+			tipData := &BlockchainData{}
+			if err = msgpack.Unmarshal(chainInfoData, tipData); err != nil {
+				return
+			}
+
+			if height == 0 || height >= tipData.Height {
+				return fmt.Errorf("Snapshot height must be between 1 and %d", tipData.Height-1)
+			}
+
+			if blockHash, err = chain.LoadBlockHash(writer, height); err != nil {
+				return
+			}
+			blockData := writer.Get("block_ByHash" + string(blockHash))
+			if blockData == nil {
+				return errors.New("Block was not found")
+			}
+
+			dataStorage := data_storage.NewDataStorage(writer)
+
+			removedTxHashes := make(map[string][]byte)
+			for index := tipData.Height - 1; index >= height; index-- {
+				if _, err = chain.removeBlockComplete(writer, index, removedTxHashes, nil, dataStorage); err != nil {
+					return
+				}
+			}
+			if err = dataStorage.CommitChanges(); err != nil {
+				return
+			}
+			for index := height; index < tipData.Height; index++ {
+				if err = chain.deleteUnusedBlocksComplete(writer, index, dataStorage); err != nil {
+					return
+				}
+			}
+
+			chainData = &BlockchainData{}
+			if err = chainData.loadBlockchainInfo(writer, height); err != nil {
+				return
+			}
+			chainData.saveBlockchainHeight(writer)
+			if err = chainData.saveBlockchain(writer); err != nil {
+				return
+			}
+
+			blk := block.CreateEmptyBlock()
+			if err = blk.Deserialize(advanced_buffers.NewBufferReader(blockData)); err != nil {
+				return
+			}
+			if !bytes.Equal(blk.PrevStateHash, chainData.StateHash) {
+				return errors.New("Block PrevStateHash is not matching the state at the snapshot height")
+			}
+
+			w := advanced_buffers.NewBufferWriter()
+			w.WriteUvarint(snapshotVersion)
+			w.WriteUvarint(config.NETWORK_SELECTED)
+			w.WriteUvarint(height)
+			w.WriteVariableBytes(blockData)
+			sw.write(w.Bytes())
+
+			writer.Range("", func(key string, value []byte) bool {
+				if !isSnapshotKeyIncluded(key) {
+					return true
+				}
+				w = advanced_buffers.NewBufferWriter()
+				w.WriteString(key)
+				w.WriteVariableBytes(value)
+				sw.write(w.Bytes())
+				return sw.err == nil
+			})
+
+			//keys are never empty, an empty key marks the end
+			w = advanced_buffers.NewBufferWriter()
+			w.WriteString("")
+			sw.write(w.Bytes())
+
+			return sw.err
+		}()
+
+		return errSnapshotRollback
+	}); err != nil && err != errSnapshotRollback {
+		return
+	}
+
+	if err = errExport; err != nil {
+		return
+	}
+
+	commitment = sw.h.Sum(nil)
+	if _, err = sw.w.Write(commitment); err != nil {
+		return
+	}
+	if err = sw.w.Flush(); err != nil {
+		return
+	}
+
+	return
+}
+
+// wipeChainStore deletes every key of the chain store. It is used to clean a failed or an interrupted snapshot import
+func wipeChainStore() (err error) {
+
+	gui.GUI.Info("Wiping the chain store")
+
+	for {
+
+		keys := make([]string, 0, snapshotImportBatch)
+		if err = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) error {
+			reader.Range("", func(key string, value []byte) bool {
+				keys = append(keys, key)
+				return len(keys) < snapshotImportBatch
+			})
+			return nil
+		}); err != nil {
+			return
+		}
+
+		if len(keys) == 0 {
+			return
+		}
+
+		if err = store.StoreBlockchain.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) error {
+			for _, key := range keys {
+				writer.Delete(key)
+			}
+			return nil
+		}); err != nil {
+			return
+		}
+	}
+}
+
+// recoverSnapshotImport wipes the chain store in case a previous snapshot import was interrupted
+func recoverSnapshotImport() (err error) {
+
+	importing := false
+	if err = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) error {
+		importing = reader.Exists(snapshotImportingKey)
+		return nil
+	}); err != nil || !importing {
+		return
+	}
+
+	gui.GUI.Warning("A previous snapshot import was interrupted")
+	return wipeChainStore()
+}
+
+// importSnapshotHashMapElement inserts the element like a new one, so its index and the keys written by the hashmap events are rebuilt
+func importSnapshotHashMapElement[T hash_map.HashMapElementSerializableInterface](hashMap *hash_map.HashMap[T], key string, value []byte) (element T, err error) {
+	if element, err = hashMap.CreateObject([]byte(key), 0); err != nil {
+		return
+	}
+	if err = element.Deserialize(advanced_buffers.NewBufferReader(value)); err != nil {
+		return
+	}
+	err = hashMap.Create(key, element)
+	return
+}
+
+func importSnapshotElement(dataStorage *data_storage.DataStorage, key string, value []byte) (err error) {
+
+	name, mapKey, ok := snapshotKeyHashMap(key)
+	if !ok {
+		return errors.New("Snapshot contains an invalid key")
+	}
+
+	switch {
+	case name == "registrations":
+		_, err = importSnapshotHashMapElement(dataStorage.Regs.HashMap, mapKey, value)
+	case name == "plainAccs":
+		var plainAcc *plain_account.PlainAccount
+		if plainAcc, err = importSnapshotHashMapElement(dataStorage.PlainAccs.HashMap, mapKey, value); err != nil {
+			return
+		}
+		//the fee liquidity heaps are rebuilt from the plain accounts
+		for _, liquidity := range plainAcc.AssetFeeLiquidities.List {
+			if err = dataStorage.AstsFeeLiquidityCollection.UpdateLiquidity(plainAcc.Key, liquidity.Rate, liquidity.LeadingZeros, liquidity.Asset, asset_fee_liquidity.UPDATE_LIQUIDITY_INSERTED); err != nil {
+				return
+			}
+		}
+	case name == "assets":
+		_, err = importSnapshotHashMapElement(dataStorage.Asts.HashMap, mapKey, value)
+	case name == "pendingStakes":
+		_, err = importSnapshotHashMapElement(dataStorage.PendingStakes.HashMap, mapKey, value)
+	case strings.HasPrefix(name, "accounts_"):
+		var accs *accounts.Accounts
+		if accs, err = dataStorage.AccsCollection.GetMap([]byte(name[len("accounts_"):])); err != nil {
+			return
+		}
+		_, err = importSnapshotHashMapElement(accs.HashMap, mapKey, value)
+	default:
+		var blockHeight uint64
+		if blockHeight, err = strconv.ParseUint(name[len("conditionalPayments_"):], 10, 64); err != nil {
+			return
+		}
+		var conditionalPayments *conditional_payments_list.ConditionalPaymentsHashMap
+		if conditionalPayments, err = dataStorage.ConditionalPaymentsCollection.GetMap(blockHeight); err != nil {
+			return
+		}
+		_, err = importSnapshotHashMapElement(conditionalPayments.HashMap, mapKey, value)
+	}
+
+	return
+}
+
+func loadSnapshotBlock(reader store_db_interface.StoreDBTransactionInterface, hash []byte) (*block.Block, error) {
+
+	data := reader.Get("block_ByHash" + string(hash))
+	if data == nil {
+		return nil, errors.New("Snapshot is missing a block")
+	}
+
+	blk := block.CreateEmptyBlock()
+	if err := blk.Deserialize(advanced_buffers.NewBufferReader(helpers.CloneBytes(data))); err != nil {
+		return nil, err
+	}
+	if err := blk.BloomNow(); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(blk.Bloom.Hash, hash) {
+		return nil, errors.New("Snapshot block is not matching its hash")
+	}
+
+	return blk, nil
+}
+
+// importSnapshot loads a snapshot into an empty chain store. The snapshot block hash must match the trusted block hash.
+// The data storage elements are inserted like new elements, so their indexes and the other keys are rebuilt, and the state tree must match the PrevStateHash of the block.
+// The blocks must be chained from the trusted block down to the genesis and the chain info is computed from them, replaying the difficulty. In case the import fails, the chain store is wiped.
+func (chain *Blockchain) importSnapshot(path string, trustedBlockHash []byte) (err error) {
+
+	if len(trustedBlockHash) != cryptography.HashSize {
+		return errors.New("Snapshot trusted block hash is invalid")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return
+	}
+	if stat.Size() < cryptography.HashSize {
+		return errors.New("Snapshot is invalid")
+	}
+
+	empty := true
+	if err = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) error {
+		reader.Range("", func(key string, value []byte) bool {
+			empty = false
+			return false
+		})
+		return nil
+	}); err != nil {
+		return
+	}
+	if !empty {
+		return errors.New("Snapshot can be imported only into an empty chain store")
+	}
+
+	if err = store.StoreBlockchain.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) error {
+		writer.Put(snapshotImportingKey, []byte{1})
+		return nil
+	}); err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			if errWipe := wipeChainStore(); errWipe != nil {
+				gui.GUI.Error("Error wiping the chain store", errWipe)
+			}
+		}
+	}()
+
+	sr := &snapshotReader{bufio.NewReader(io.LimitReader(f, stat.Size()-cryptography.HashSize)), sha3.New256()}
+
+	var version, network, height uint64
+	var blockData []byte
+
+	if version, err = sr.readUvarint(); err != nil {
+		return
+	}
+	if version != snapshotVersion {
+		return errors.New("Snapshot version is not supported")
+	}
+	if network, err = sr.readUvarint(); err != nil {
+		return
+	}
+	if network != config.NETWORK_SELECTED {
+		return errors.New("Snapshot was created for a different network")
+	}
+	if height, err = sr.readUvarint(); err != nil {
+		return
+	}
+	if blockData, err = sr.readVariableBytes(snapshotValueMaxLength); err != nil {
+		return
+	}
+
+	if !bytes.Equal(cryptography.SHA3(blockData), trustedBlockHash) {
+		return errors.New("Snapshot block hash is not matching the trusted block hash")
+	}
+
+	blk := block.CreateEmptyBlock()
+	if err = blk.Deserialize(advanced_buffers.NewBufferReader(blockData)); err != nil {
+		return
+	}
+	if height == 0 || blk.Height != height {
+		return errors.New("Snapshot block height is not matching")
+	}
+
+	gui.GUI.Info("Importing snapshot", height)
+
+	var blocksCount, blocksTxsCount uint64
+	count := 0
+
+	for finished := false; !finished; {
+
+		if err = store.StoreBlockchain.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) (err error) {
+
+			dataStorage := data_storage.NewDataStorage(writer)
+
+			var key, value []byte
+			for i := 0; i < snapshotImportBatch; i++ {
+
+				if key, err = sr.readVariableBytes(snapshotKeyMaxLength); err != nil {
+					return
+				}
+				if len(key) == 0 {
+					finished = true
+					break
+				}
+				if value, err = sr.readVariableBytes(snapshotValueMaxLength); err != nil {
+					return
+				}
+
+				switch {
+				case strings.HasPrefix(string(key), "block_ByHash"):
+					if !bytes.Equal(cryptography.SHA3(value), key[len("block_ByHash"):]) {
+						return errors.New("Snapshot block is not matching its hash")
+					}
+					if writer.Exists(string(key)) {
+						return errors.New("Snapshot contains a duplicate block")
+					}
+					writer.Put(string(key), value)
+					blocksCount += 1
+				case strings.HasPrefix(string(key), "blockTxs"):
+					if writer.Exists(string(key)) {
+						return errors.New("Snapshot contains duplicate block txs")
+					}
+					writer.Put(string(key), value)
+					blocksTxsCount += 1
+				default:
+					if err = importSnapshotElement(dataStorage, string(key), value); err != nil {
+						return
+					}
+				}
+				count += 1
+			}
+
+			if err = dataStorage.CommitChanges(); err != nil {
+				return
+			}
+			if config.NODE_PROVIDE_EXTENDED_INFO_APP {
+				return saveAssetsInfo(dataStorage.Asts)
+			}
+			return
+		}); err != nil {
+			return
+		}
+	}
+
+	commitment := make([]byte, cryptography.HashSize)
+	if _, err = f.ReadAt(commitment, stat.Size()-cryptography.HashSize); err != nil {
+		return
+	}
+	if !bytes.Equal(sr.h.Sum(nil), commitment) {
+		return errors.New("Snapshot commitment is not matching")
+	}
+	if _, err = sr.r.ReadByte(); err != io.EOF {
+		return errors.New("Snapshot contains data after the end")
+	}
+
+	if blocksCount != height || blocksTxsCount != height {
+		return errors.New("Snapshot contains blocks that are not chained")
+	}
+
+	gui.GUI.Info("Verifying the snapshot blocks")
+
+	//the blocks are verified from the trusted block down to the genesis and indexed by height
+	hash, kernelHash := blk.PrevHash, blk.PrevKernelHash
+	var prevHash, prevKernelHash []byte
+	var txsCount uint64
+
+	for end := height; end > 0; {
+
+		if err = store.StoreBlockchain.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) (err error) {
+
+			start := end - generics.Min(end, snapshotImportBatch)
+			for ; end > start; end-- {
+
+				var prev *block.Block
+				if prev, err = loadSnapshotBlock(writer, hash); err != nil {
+					return
+				}
+				if prev.Height != end-1 || !bytes.Equal(prev.Bloom.KernelHash, kernelHash) {
+					return errors.New("Snapshot blocks are not chained")
+				}
+
+				heightStr := strconv.FormatUint(prev.Height, 10)
+				writer.Put("blockHash_ByHeight"+heightStr, hash)
+				writer.Put("blockKernelHash_ByHeight"+heightStr, kernelHash)
+				writer.Put("blockHeight_ByHash"+string(hash), []byte(heightStr))
+
+				data := writer.Get("blockTxs" + heightStr)
+				if data == nil {
+					return errors.New("Snapshot is missing the block txs")
+				}
+				txHashes := [][]byte{}
+				if err = msgpack.Unmarshal(data, &txHashes); err != nil {
+					return
+				}
+
+				merkleHash := cryptography.SHA3([]byte{})
+				if len(txHashes) > 0 {
+					merkleHash = merkle_tree.MerkleRoot(txHashes)
+				}
+				if !bytes.Equal(merkleHash, prev.MerkleHash) {
+					return errors.New("Snapshot block txs are not matching the block")
+				}
+
+				buf := make([]byte, binary.MaxVarintLen64)
+				n := binary.PutUvarint(buf, prev.Height)
+				for _, txHash := range txHashes {
+					writer.Put("txHash:"+string(txHash), []byte{1})
+					writer.Put("txBlock:"+string(txHash), buf[:n])
+				}
+				txsCount += uint64(len(txHashes))
+
+				if end == height {
+					prevHash, prevKernelHash = prev.PrevHash, prev.PrevKernelHash
+				}
+				hash, kernelHash = prev.PrevHash, prev.PrevKernelHash
+			}
+			return
+		}); err != nil {
+			return
+		}
+	}
+
+	if !bytes.Equal(hash, genesis.GenesisData.Hash) || !bytes.Equal(kernelHash, genesis.GenesisData.KernelHash) {
+		return errors.New("Snapshot blocks are not chained to the genesis")
+	}
+
+	gui.GUI.Info("Replaying the snapshot difficulty")
+
+	headersDifficulty, err := chain.OpenLoadHeadersDifficulty(0)
+	if err != nil {
+		return
+	}
+
+	for start := uint64(0); start < height; {
+
+		if err = store.StoreBlockchain.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) (err error) {
+
+			end := generics.Min(height, start+snapshotImportBatch)
+			for ; start < end; start++ {
+
+				var hash []byte
+				if hash, err = chain.LoadBlockHash(writer, start); err != nil {
+					return
+				}
+
+				var blk *block.Block
+				if blk, err = loadSnapshotBlock(writer, helpers.CloneBytes(hash)); err != nil {
+					return
+				}
+				if err = headersDifficulty.Add(blk); err != nil {
+					return
+				}
+
+				entry := headersDifficulty.entries[headersDifficulty.height]
+				chainData := &BlockchainData{Height: headersDifficulty.height, Timestamp: entry.timestamp, BigTotalDifficulty: entry.bigTotalDifficulty}
+				chainData.saveTotalDifficultyExtra(writer)
+			}
+			return
+		}); err != nil {
+			return
+		}
+	}
+
+	//the trusted block must meet the replayed target
+	if err = blk.BloomNow(); err != nil {
+		return
+	}
+	if err = headersDifficulty.Clone().Add(blk); err != nil {
+		return
+	}
+
+	return store.StoreBlockchain.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		stateHash := state_tree.NewStateTree(writer).Root()
+		if !bytes.Equal(stateHash, blk.PrevStateHash) {
+			return errors.New("Snapshot state is not matching the block PrevStateHash")
+		}
+
+		dataStorage := data_storage.NewDataStorage(writer)
+
+		var ast *asset.Asset
+		if ast, err = dataStorage.Asts.Get(string(config_coins.NATIVE_ASSET_FULL)); err != nil {
+			return
+		}
+		if ast == nil {
+			return errors.New("Snapshot is missing the native asset")
+		}
+
+		entry := headersDifficulty.entries[height]
+
+		chainData := &BlockchainData{
+			Hash:               blk.PrevHash,
+			PrevHash:           prevHash,
+			KernelHash:         blk.PrevKernelHash,
+			PrevKernelHash:     prevKernelHash,
+			StateHash:          stateHash,
+			Height:             height,
+			Timestamp:          entry.timestamp,
+			Target:             headersDifficulty.Target,
+			BigTotalDifficulty: entry.bigTotalDifficulty,
+			TransactionsCount:  txsCount,
+			AccountsCount:      dataStorage.Regs.Count + dataStorage.PlainAccs.Count,
+			AssetsCount:        dataStorage.Asts.Count,
+			Supply:             ast.Supply,
+		}
+
+		//there are no rollback transitions and no transactions bodies below the snapshot height
+		savePrunedHeight(writer, "chainPrunedHeight", height)
+		savePrunedHeight(writer, "chainPrunedTxsHeight", height)
+
+		chainData.saveBlockchainHeight(writer)
+		if err = chainData.saveBlockchainInfo(writer); err != nil {
+			return
+		}
+		if err = chainData.saveBlockchain(writer); err != nil {
+			return
+		}
+		writer.Delete(snapshotImportingKey)
+
+		gui.GUI.Info("Snapshot imported", height, count, "keys", base64.StdEncoding.EncodeToString(stateHash))
+		return
+	})
+}
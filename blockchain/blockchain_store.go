@@ -3,12 +3,14 @@ package blockchain
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"github.com/vmihailenco/msgpack/v5"
 	"pandora-pay/blockchain/blockchain_types"
 	"pandora-pay/blockchain/blocks/block_complete"
 	"pandora-pay/blockchain/data_storage"
 	"pandora-pay/config"
 	"pandora-pay/helpers"
+	"pandora-pay/helpers/generics"
 	"pandora-pay/store"
 	"pandora-pay/store/store_db/store_db_interface"
 	"strconv"
@@ -73,11 +75,98 @@ func (chain *Blockchain) deleteUnusedBlocksComplete(writer store_db_interface.St
 	return nil
 }
 
+func loadPrunedHeight(reader store_db_interface.StoreDBTransactionInterface, key string) (height uint64) {
+	if data := reader.Get(key); data != nil {
+		height, _ = binary.Uvarint(data)
+	}
+	return
+}
+
+func savePrunedHeight(writer store_db_interface.StoreDBTransactionInterface, key string, height uint64) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, height)
+	writer.Put(key, buf[:n])
+}
+
+// blocks below this height have their transactions bodies dropped
+func (chain *Blockchain) LoadPrunedTxsHeight(reader store_db_interface.StoreDBTransactionInterface) uint64 {
+	return loadPrunedHeight(reader, "chainPrunedTxsHeight")
+}
+
+func (chain *Blockchain) IsTxPruned(reader store_db_interface.StoreDBTransactionInterface, hashStr string) bool {
+	return !reader.Exists("tx:"+hashStr) && reader.Exists("txHash:"+hashStr)
+}
+
+// keeps only the last config.PRUNE_BLOCKS heights of transitional changes and optionally of transactions bodies
+func (chain *Blockchain) pruneBlocksComplete(writer store_db_interface.StoreDBTransactionInterface, chainHeight, consecutiveSelfForged uint64, dataStorage *data_storage.DataStorage) (err error) {
+
+	if config.PRUNE_BLOCKS == 0 {
+		return
+	}
+
+	//never prune the blocks that could still be removed by a fork
+	keep := generics.Max(config.PRUNE_BLOCKS, config.FORK_MAX_UNCLE_ALLOWED+consecutiveSelfForged+1)
+	if chainHeight <= keep {
+		return
+	}
+	pruneEnd := chainHeight - keep
+
+	start := loadPrunedHeight(writer, "chainPrunedHeight")
+	end := generics.Min(pruneEnd, start+config.PRUNE_MAX_BLOCKS_PER_UPDATE)
+
+	for height := start; height < end; height++ {
+		blockHeightStr := strconv.FormatUint(height, 10)
+		if writer.Exists("dataStorage:transitionsCollectionsKeys:" + blockHeightStr) {
+			if err = dataStorage.DeleteTransitionalChangesFromStore(blockHeightStr); err != nil {
+				return
+			}
+		}
+	}
+	if end > start {
+		savePrunedHeight(writer, "chainPrunedHeight", end)
+	}
+
+	if !config.PRUNE_TXS {
+		return
+	}
+
+	//the txs can be pruned later than the transitions in case --prune-txs was enabled afterwards
+	start = loadPrunedHeight(writer, "chainPrunedTxsHeight")
+	end = generics.Min(pruneEnd, start+config.PRUNE_MAX_BLOCKS_PER_UPDATE)
+
+	for height := start; height < end; height++ {
+
+		data := writer.Get("blockTxs" + strconv.FormatUint(height, 10))
+		if data == nil {
+			continue
+		}
+
+		txHashes := [][]byte{}
+		if err = msgpack.Unmarshal(data, &txHashes); err != nil {
+			return
+		}
+
+		//txHash: and txBlock: are kept to avoid including the same tx twice
+		for _, txHash := range txHashes {
+			writer.Delete("tx:" + string(txHash))
+		}
+	}
+	if end > start {
+		savePrunedHeight(writer, "chainPrunedTxsHeight", end)
+	}
+
+	return
+}
+
 func (chain *Blockchain) removeBlockComplete(writer store_db_interface.StoreDBTransactionInterface, blockHeight uint64, removedTxHashes map[string][]byte, allTransactionsChanges []*blockchain_types.BlockchainTransactionUpdate, dataStorage *data_storage.DataStorage) (allTransactionsChanges2 []*blockchain_types.BlockchainTransactionUpdate, err error) {
 
 	allTransactionsChanges2 = allTransactionsChanges
 	allTransactionsChangesFinal := allTransactionsChanges
 
+	if blockHeight < loadPrunedHeight(writer, "chainPrunedHeight") {
+		return allTransactionsChanges, fmt.Errorf("Block %d was pruned and can not be removed", blockHeight)
+	}
+
 	blockHeightStr := strconv.FormatUint(blockHeight, 10)
 	blockHeightNextStr := strconv.FormatUint(blockHeight, 10)
 
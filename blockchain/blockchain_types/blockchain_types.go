@@ -32,15 +32,17 @@ type MempoolTransactionUpdate struct {
 	Tx                               *transaction.Transaction
 	IncludedInBlockchainNotification bool
 	Keys                             map[string]bool
+	ReplacedBy                       []byte
 }
 
 type BlockchainUpdates struct {
-	AccsCollection *accounts.AccountsCollection
-	PlainAccounts  *plain_accounts.PlainAccounts
-	Assets         *assets.Assets
-	Registrations  *registrations.Registrations
-	BlockHeight    uint64
-	BlockHash      []byte
+	AccsCollection      *accounts.AccountsCollection
+	PlainAccounts       *plain_accounts.PlainAccounts
+	Assets              *assets.Assets
+	Registrations       *registrations.Registrations
+	BlockHeight         uint64
+	BlockHash           []byte
+	TransactionsChanges []*BlockchainTransactionUpdate
 }
 
 type BlockchainSolutionAnswer struct {
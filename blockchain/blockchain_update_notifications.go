@@ -47,6 +47,11 @@ func (queue *BlockchainUpdatesQueue) processBlockchainUpdateMempool() {
 				queue.chain.mempool.RemoveInsertedTxsFromBlockchain(hashes)
 			}
 
+			for _, blkComplete := range update.insertedBlocks {
+				queue.chain.mempool.Fees.AddBlockTxs(blkComplete.Block.Height, blkComplete.Txs)
+			}
+			queue.chain.mempool.Fees.RemoveBlocks(update.newChainData.Height)
+
 			//let's add the transactions in the mempool
 			if len(update.removedTxsList) > 0 {
 
@@ -9,6 +9,7 @@ import (
 type Block struct {
 	*BlockHeader
 	MerkleHash     []byte      `json:"merkleHash" msgpack:"merkleHash"`          //32 byte
+	PrevStateHash  []byte      `json:"prevStateHash" msgpack:"prevStateHash"`    //32 byte state root after the previous block
 	PrevHash       []byte      `json:"prevHash"  msgpack:"prevHash"`             //32 byte
 	PrevKernelHash []byte      `json:"prevKernelHash"  msgpack:"prevKernelHash"` //32 byte
 	Timestamp      uint64      `json:"timestamp" msgpack:"timestamp"`
@@ -57,6 +58,7 @@ func (blk *Block) AdvancedSerialization(w *advanced_buffers.BufferWriter, kernel
 
 	if !kernelHash {
 		w.Write(blk.MerkleHash)
+		w.Write(blk.PrevStateHash)
 		w.Write(blk.PrevHash)
 	}
 
@@ -96,6 +98,9 @@ func (blk *Block) Deserialize(r *advanced_buffers.BufferReader) (err error) {
 	if blk.MerkleHash, err = r.ReadHash(); err != nil {
 		return
 	}
+	if blk.PrevStateHash, err = r.ReadHash(); err != nil {
+		return
+	}
 	if blk.PrevHash, err = r.ReadHash(); err != nil {
 		return
 	}
@@ -12,22 +12,23 @@ import (
 
 var (
 	merkleHash     = cryptography.SHA3([]byte("MerkleHash"))
+	prevStateHash  = cryptography.SHA3([]byte("PrevStateHash"))
 	prevHash       = cryptography.SHA3([]byte("PrevHash"))
 	prevKernelHash = cryptography.SHA3([]byte("PrevKernelHash"))
+	stakingNonce   = cryptography.SHA3([]byte("StakingNonce"))
 )
 
 func TestBlock_Serialize(t *testing.T) {
 	var err error
 
-	privateKey := addresses.GenerateNewPrivateKey()
-	publicKey := privateKey.GeneratePublicKey()
-
 	blk := Block{
 		BlockHeader:    &BlockHeader{Version: 0, Height: 0},
 		MerkleHash:     merkleHash,
+		PrevStateHash:  prevStateHash,
 		PrevHash:       prevHash,
 		PrevKernelHash: prevKernelHash,
 		Timestamp:      uint64(time.Now().Unix()),
+		StakingNonce:   stakingNonce,
 	}
 
 	buf := blk.SerializeManualToBytes()
@@ -48,15 +49,16 @@ func TestBlock_SerializeForSigning(t *testing.T) {
 	var err error
 
 	privateKey := addresses.GenerateNewPrivateKey()
-	publicKey := privateKey.GeneratePublicKey()
 
 	blockHeader := &BlockHeader{Version: 0, Height: 0}
 	blk := Block{
 		BlockHeader:    blockHeader,
 		MerkleHash:     merkleHash,
+		PrevStateHash:  prevStateHash,
 		PrevHash:       prevHash,
 		PrevKernelHash: prevKernelHash,
 		Timestamp:      uint64(time.Now().Unix()),
+		StakingNonce:   stakingNonce,
 	}
 
 	hash := blk.SerializeForSigning()
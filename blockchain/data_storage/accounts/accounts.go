@@ -70,6 +70,8 @@ func NewAccounts(tx store_db_interface.StoreDBTransactionInterface, AssetId []by
 		AssetId,
 	}
 
+	accounts.HashMap.Authenticated = true
+
 	accounts.HashMap.CreateObject = func(key []byte, index uint64) (*account.Account, error) {
 		return account.NewAccountClear(key, index, accounts.Asset), nil
 	}
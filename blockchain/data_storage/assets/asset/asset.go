@@ -19,6 +19,11 @@ var regexAssetName = regexp.MustCompile("^([a-zA-Z0-9]+ )+[a-zA-Z0-9]+$|^[a-zA-Z
 var regexAssetTicker = regexp.MustCompile("^[A-Z0-9]+$") // only lowercase ascii is allowed. No space allowed
 var regexAssetDescription = regexp.MustCompile("[\\w|\\W]+")
 
+const (
+	ASSET_VERSION_SIMPLE uint64 = 0
+	ASSET_VERSION_ADMIN  uint64 = 1 //pause and freeze
+)
+
 type Asset struct {
 	PublicKeyHash            []byte `json:"-" msgpack:"-"` //hashmap key
 	Index                    uint64 `json:"-" msgpack:"-"` //hashMap index
@@ -30,6 +35,8 @@ type Asset struct {
 	CanChangeSupplyPublicKey bool   `json:"canChangeSupplyPublicKey,omitempty" msgpack:"canChangeSupplyPublicKey,omitempty"` //can change supply key
 	CanPause                 bool   `json:"canPause,omitempty" msgpack:"canPause,omitempty"`                                 //can pause (suspend transactions)
 	CanFreeze                bool   `json:"canFreeze,omitempty" msgpack:"canFreeze,omitempty"`                               //freeze supply changes
+	Paused                   bool   `json:"paused,omitempty" msgpack:"paused,omitempty"`                                     //transactions are suspended
+	Frozen                   bool   `json:"frozen,omitempty" msgpack:"frozen,omitempty"`                                     //minting is frozen permanently
 	DecimalSeparator         byte   `json:"decimalSeparator,omitempty" msgpack:"decimalSeparator,omitempty"`
 	MaxSupply                uint64 `json:"maxSupply,omitempty" msgpack:"maxSupply,omitempty"`
 	Supply                   uint64 `json:"supply,omitempty" msgpack:"supply,omitempty"`
@@ -62,6 +69,13 @@ func (asset *Asset) GetIndex() uint64 {
 }
 
 func (asset *Asset) Validate() error {
+	if asset.Version > ASSET_VERSION_ADMIN {
+		return errors.New("Invalid Asset Version")
+	}
+	if asset.Version == ASSET_VERSION_SIMPLE && (asset.Paused || asset.Frozen) {
+		return errors.New("Asset pause and freeze require version 1")
+	}
+
 	if asset.DecimalSeparator > config_assets.ASSETS_DECIMAL_SEPARATOR_MAX_BYTE {
 		return errors.New("asset decimal separator is invalid")
 	}
@@ -89,6 +103,13 @@ func (asset *Asset) Validate() error {
 		return errors.New("Asset description is invalid")
 	}
 
+	if asset.Paused && !asset.CanPause {
+		return errors.New("Asset can not be paused")
+	}
+	if asset.Frozen && !asset.CanFreeze {
+		return errors.New("Asset can not be frozen")
+	}
+
 	if len(asset.PublicKeyHash) != cryptography.PublicKeyHashSize {
 		return errors.New("Asset Public key is invalid")
 	}
@@ -154,6 +175,9 @@ func (asset *Asset) AddSupply(sign bool, amount uint64) error {
 	}
 
 	if sign {
+		if asset.Frozen {
+			return errors.New("Asset supply is frozen")
+		}
 		if !asset.CanMint {
 			return errors.New("Can't mint")
 		}
@@ -183,6 +207,10 @@ func (asset *Asset) Serialize(w *advanced_buffers.BufferWriter) {
 	w.WriteBool(asset.CanChangeSupplyPublicKey)
 	w.WriteBool(asset.CanPause)
 	w.WriteBool(asset.CanFreeze)
+	if asset.Version == ASSET_VERSION_ADMIN {
+		w.WriteBool(asset.Paused)
+		w.WriteBool(asset.Frozen)
+	}
 	w.WriteByte(asset.DecimalSeparator)
 
 	w.WriteUvarint(asset.MaxSupply)
@@ -210,6 +238,9 @@ func (asset *Asset) Deserialize(r *advanced_buffers.BufferReader) (err error) {
 	if asset.Version, err = r.ReadUvarint(); err != nil {
 		return
 	}
+	if asset.Version > ASSET_VERSION_ADMIN {
+		return errors.New("Invalid Asset Version")
+	}
 	if asset.CanUpgrade, err = r.ReadBool(); err != nil {
 		return
 	}
@@ -231,6 +262,14 @@ func (asset *Asset) Deserialize(r *advanced_buffers.BufferReader) (err error) {
 	if asset.CanFreeze, err = r.ReadBool(); err != nil {
 		return
 	}
+	if asset.Version == ASSET_VERSION_ADMIN {
+		if asset.Paused, err = r.ReadBool(); err != nil {
+			return
+		}
+		if asset.Frozen, err = r.ReadBool(); err != nil {
+			return
+		}
+	}
 	if asset.DecimalSeparator, err = r.ReadByte(); err != nil {
 		return
 	}
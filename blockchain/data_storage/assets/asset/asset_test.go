@@ -0,0 +1,49 @@
+package asset
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pandora-pay/cryptography"
+	"pandora-pay/helpers"
+	"pandora-pay/helpers/advanced_buffers"
+	"testing"
+)
+
+func TestAsset_SerializeVersions(t *testing.T) {
+
+	serialize := func(ast *Asset) []byte {
+		w := advanced_buffers.NewBufferWriter()
+		ast.Serialize(w)
+		return w.Bytes()
+	}
+
+	ast := &Asset{
+		Version:         ASSET_VERSION_SIMPLE,
+		CanPause:        true,
+		CanFreeze:       true,
+		UpdatePublicKey: helpers.RandomBytes(cryptography.PublicKeySize),
+		SupplyPublicKey: helpers.RandomBytes(cryptography.PublicKeySize),
+		Name:            "My Asset",
+		Ticker:          "AST",
+	}
+
+	//version 0 keeps the original encoding with canPause and canFreeze
+	data := serialize(ast)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 0}, data[:11])
+
+	ast2 := NewAsset(helpers.RandomBytes(cryptography.PublicKeyHashSize), 0)
+	assert.NoError(t, ast2.Deserialize(advanced_buffers.NewBufferReader(data)))
+	assert.True(t, ast2.CanPause)
+	assert.True(t, ast2.CanFreeze)
+	assert.Equal(t, data, serialize(ast2))
+
+	//only version 1 stores the paused and frozen state
+	ast.Version = ASSET_VERSION_ADMIN
+	ast.Paused = true
+	data = serialize(ast)
+
+	ast2 = NewAsset(helpers.RandomBytes(cryptography.PublicKeyHashSize), 0)
+	assert.NoError(t, ast2.Deserialize(advanced_buffers.NewBufferReader(data)))
+	assert.True(t, ast2.Paused)
+	assert.False(t, ast2.Frozen)
+	assert.Equal(t, data, serialize(ast2))
+}
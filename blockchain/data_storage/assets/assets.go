@@ -31,6 +31,8 @@ func NewAssets(tx store_db_interface.StoreDBTransactionInterface) (this *Assets)
 		hash_map.CreateNewHashMap[*asset.Asset](tx, "assets", config_coins.ASSET_LENGTH, true),
 	}
 
+	this.HashMap.Authenticated = true
+
 	this.HashMap.CreateObject = func(key []byte, index uint64) (*asset.Asset, error) {
 		return asset.NewAsset(key, index), nil
 	}
@@ -3,7 +3,10 @@ package data_storage
 import (
 	"errors"
 	"github.com/vmihailenco/msgpack/v5"
+	"pandora-pay/blockchain/data_storage/accounts"
+	"pandora-pay/blockchain/data_storage/assets/asset"
 	"pandora-pay/store/hash_map"
+	"pandora-pay/store/state_tree"
 	"pandora-pay/store/store_db/store_db_interface"
 )
 
@@ -242,3 +245,31 @@ func (dataStorage *DataStorage) DeleteTransitionalChangesFromStore(prefix string
 	dataStorage.DBTx.Delete("dataStorage:transitionsCollectionsKeys:" + prefix)
 	return nil
 }
+
+// ComputeStateTree rebuilds the state tree from the committed registrations, plain accounts, assets and accounts
+func (dataStorage *DataStorage) ComputeStateTree() (err error) {
+
+	leaves := make(map[string][]byte)
+
+	dataStorage.Regs.ComputeStateLeaves(leaves)
+	dataStorage.PlainAccs.ComputeStateLeaves(leaves)
+	dataStorage.Asts.ComputeStateLeaves(leaves)
+
+	assetsIds := make([][]byte, 0)
+	if err = dataStorage.Asts.Range(func(key []byte, ast *asset.Asset) (bool, error) {
+		assetsIds = append(assetsIds, key)
+		return true, nil
+	}); err != nil {
+		return
+	}
+
+	for _, assetId := range assetsIds {
+		var accs *accounts.Accounts
+		if accs, err = dataStorage.AccsCollection.GetMap(assetId); err != nil {
+			return
+		}
+		accs.ComputeStateLeaves(leaves)
+	}
+
+	return state_tree.NewStateTree(dataStorage.DBTx).Update(leaves)
+}
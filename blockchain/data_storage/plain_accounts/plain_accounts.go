@@ -26,6 +26,8 @@ func NewPlainAccounts(tx store_db_interface.StoreDBTransactionInterface) (this *
 		hash_map.CreateNewHashMap[*plain_account.PlainAccount](tx, "plainAccs", cryptography.PublicKeySize, false),
 	}
 
+	this.HashMap.Authenticated = true
+
 	this.HashMap.CreateObject = func(key []byte, index uint64) (*plain_account.PlainAccount, error) {
 		return plain_account.NewPlainAccount(key, index), nil
 	}
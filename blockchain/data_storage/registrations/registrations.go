@@ -52,6 +52,8 @@ func NewRegistrations(tx store_db_interface.StoreDBTransactionInterface) (this *
 		hash_map.CreateNewHashMap[*registration.Registration](tx, "registrations", cryptography.PublicKeySize, true),
 	}
 
+	this.HashMap.Authenticated = true
+
 	this.HashMap.CreateObject = func(key []byte, index uint64) (*registration.Registration, error) {
 		return registration.NewRegistration(key, index), nil
 	}
@@ -73,7 +73,7 @@ func getGenesis() (*GenesisDataType, error) {
 	}
 }
 
-func CreateNewGenesisBlock() (*block.Block, error) {
+func CreateNewGenesisBlock(stateHash []byte) (*block.Block, error) {
 
 	var blk = block.Block{
 		BlockHeader: &block.BlockHeader{
@@ -81,6 +81,7 @@ func CreateNewGenesisBlock() (*block.Block, error) {
 			Height:  0,
 		},
 		MerkleHash:     cryptography.SHA3([]byte{}),
+		PrevStateHash:  stateHash,
 		Timestamp:      GenesisData.Timestamp,
 		PrevHash:       GenesisData.Hash,
 		PrevKernelHash: GenesisData.KernelHash,
@@ -249,7 +250,8 @@ func GenesisInit(walletGetFirstAddressForDevnetGenesisAirdrop func() (string, *s
 
 	}
 
-	if Genesis, err = CreateNewGenesisBlock(); err != nil {
+	//the state is not known before the chain is initialized
+	if Genesis, err = CreateNewGenesisBlock(nil); err != nil {
 		return
 	}
 
@@ -7,5 +7,7 @@ type AssetInfo struct {
 	Identification   string `json:"identification" msgpack:"identification"`
 	DecimalSeparator byte   `json:"decimalSeparator" msgpack:"decimalSeparator"`
 	Description      string `json:"description,omitempty" msgpack:"description,omitempty"`
+	Paused           bool   `json:"paused,omitempty" msgpack:"paused,omitempty"`
+	Frozen           bool   `json:"frozen,omitempty" msgpack:"frozen,omitempty"`
 	Hash             []byte `json:"hash,omitempty" msgpack:"hash,omitempty"`
 }
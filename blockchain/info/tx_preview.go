@@ -84,6 +84,15 @@ func CreateTxPreviewFromTx(tx *transaction.Transaction) (*TxPreview, error) {
 				payloadExtra = &TxPreviewZetherPayloadExtraStaking{}
 			case transaction_zether_payload_script.SCRIPT_SPEND:
 				payloadExtra = &TxPreviewZetherPayloadExtraSpend{}
+			case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE:
+				txPayloadExtra := payload.Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetSupplyDecrease)
+				payloadExtra = &TxPreviewZetherPayloadExtraAssetSupplyDecrease{txPayloadExtra.AssetSupplyPublicKey}
+			case transaction_zether_payload_script.SCRIPT_ASSET_ADMIN:
+				txPayloadExtra := payload.Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetAdmin)
+				payloadExtra = &TxPreviewZetherPayloadExtraAssetAdmin{txPayloadExtra.AssetId, txPayloadExtra.Paused, txPayloadExtra.Freeze}
+			case transaction_zether_payload_script.SCRIPT_ASSET_UPDATE:
+				txPayloadExtra := payload.Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetUpdate)
+				payloadExtra = &TxPreviewZetherPayloadExtraAssetUpdate{txPayloadExtra.AssetId, txPayloadExtra.UpdateInfo, txPayloadExtra.NewUpdatePublicKey, txPayloadExtra.NewSupplyPublicKey}
 			case transaction_zether_payload_script.SCRIPT_CONDITIONAL_PAYMENT:
 				txPayloadExtra := payload.Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraConditionalPayment)
 				payloadExtra = &TxPreviewZetherPayloadExtraPayToScript{txPayloadExtra.Deadline, txPayloadExtra.DefaultResolution, txPayloadExtra.MultisigThreshold}
@@ -15,6 +15,23 @@ type TxPreviewZetherPayloadExtraStaking struct {
 type TxPreviewZetherPayloadExtraSpend struct {
 }
 
+type TxPreviewZetherPayloadExtraAssetSupplyDecrease struct {
+	AssetSupplyPublicKey []byte `json:"assetSupplyPublicKey" msgpack:"assetSupplyPublicKey"`
+}
+
+type TxPreviewZetherPayloadExtraAssetAdmin struct {
+	AssetId []byte `json:"assetId" msgpack:"assetId"`
+	Paused  bool   `json:"paused" msgpack:"paused"`
+	Freeze  bool   `json:"freeze" msgpack:"freeze"`
+}
+
+type TxPreviewZetherPayloadExtraAssetUpdate struct {
+	AssetId            []byte `json:"assetId" msgpack:"assetId"`
+	UpdateInfo         bool   `json:"updateInfo" msgpack:"updateInfo"`
+	NewUpdatePublicKey []byte `json:"newUpdatePublicKey,omitempty" msgpack:"newUpdatePublicKey,omitempty"`
+	NewSupplyPublicKey []byte `json:"newSupplyPublicKey,omitempty" msgpack:"newSupplyPublicKey,omitempty"`
+}
+
 type TxPreviewZetherPayloadExtraPayToScript struct {
 	Deadline          uint64 `json:"deadline" msgpack:"dealine"`
 	DefaultResolution bool   `json:"defaultResolution" msgpack:"defaultResolution"`
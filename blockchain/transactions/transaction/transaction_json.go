@@ -100,6 +100,30 @@ type json_Only_TransactionZetherPayloadExtraAssetSupplyIncrease struct {
 	AssetSignature       []byte `json:"assetSignature"  msgpack:"assetSignature"`
 }
 
+type json_Only_TransactionZetherPayloadExtraAssetSupplyDecrease struct {
+	AssetSupplyPublicKey []byte `json:"assetSupplyPublicKey"  msgpack:"assetSupplyPublicKey"`
+	AssetSignature       []byte `json:"assetSignature"  msgpack:"assetSignature"`
+}
+
+type json_Only_TransactionZetherPayloadExtraAssetAdmin struct {
+	AssetId              []byte `json:"assetId"  msgpack:"assetId"`
+	Paused               bool   `json:"paused"  msgpack:"paused"`
+	Freeze               bool   `json:"freeze"  msgpack:"freeze"`
+	AssetUpdatePublicKey []byte `json:"assetUpdatePublicKey"  msgpack:"assetUpdatePublicKey"`
+	AssetSignature       []byte `json:"assetSignature"  msgpack:"assetSignature"`
+}
+
+type json_Only_TransactionZetherPayloadExtraAssetUpdate struct {
+	AssetId              []byte `json:"assetId"  msgpack:"assetId"`
+	UpdateInfo           bool   `json:"updateInfo"  msgpack:"updateInfo"`
+	Description          string `json:"description"  msgpack:"description"`
+	Data                 []byte `json:"data"  msgpack:"data"`
+	NewUpdatePublicKey   []byte `json:"newUpdatePublicKey"  msgpack:"newUpdatePublicKey"`
+	NewSupplyPublicKey   []byte `json:"newSupplyPublicKey"  msgpack:"newSupplyPublicKey"`
+	AssetUpdatePublicKey []byte `json:"assetUpdatePublicKey"  msgpack:"assetUpdatePublicKey"`
+	AssetSignature       []byte `json:"assetSignature"  msgpack:"assetSignature"`
+}
+
 type json_Only_TransactionZetherPayloadExtraPlainAccountFund struct {
 	PlainAccountPublicKey []byte `json:"plainAccountPublicKey"  msgpack:"plainAccountPublicKey"`
 }
@@ -265,6 +289,33 @@ func marshalJSON(tx *Transaction, marshal func(any) ([]byte, error)) ([]byte, er
 					payloadExtra.AssetSignature,
 					payloadExtra.AssetSupplyPublicKey,
 				}
+			case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE:
+				payloadExtra := payload.Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetSupplyDecrease)
+				extra = &json_Only_TransactionZetherPayloadExtraAssetSupplyDecrease{
+					payloadExtra.AssetSupplyPublicKey,
+					payloadExtra.AssetSignature,
+				}
+			case transaction_zether_payload_script.SCRIPT_ASSET_ADMIN:
+				payloadExtra := payload.Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetAdmin)
+				extra = &json_Only_TransactionZetherPayloadExtraAssetAdmin{
+					payloadExtra.AssetId,
+					payloadExtra.Paused,
+					payloadExtra.Freeze,
+					payloadExtra.AssetUpdatePublicKey,
+					payloadExtra.AssetSignature,
+				}
+			case transaction_zether_payload_script.SCRIPT_ASSET_UPDATE:
+				payloadExtra := payload.Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetUpdate)
+				extra = &json_Only_TransactionZetherPayloadExtraAssetUpdate{
+					payloadExtra.AssetId,
+					payloadExtra.UpdateInfo,
+					payloadExtra.Description,
+					payloadExtra.Data,
+					payloadExtra.NewUpdatePublicKey,
+					payloadExtra.NewSupplyPublicKey,
+					payloadExtra.AssetUpdatePublicKey,
+					payloadExtra.AssetSignature,
+				}
 			case transaction_zether_payload_script.SCRIPT_PLAIN_ACCOUNT_FUND:
 				payloadExtra := payload.Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraPlainAccountFund)
 				extra = &json_Only_TransactionZetherPayloadExtraPlainAccountFund{
@@ -547,6 +598,45 @@ func (tx *Transaction) UnmarshalJSON(data []byte) (err error) {
 					extraJson.AssetSignature,
 					extraJson.AssetSupplyPublicKey,
 				}
+			case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE:
+				extraJson := &json_Only_TransactionZetherPayloadExtraAssetSupplyDecrease{}
+				if err = json.Unmarshal(data, extraJson); err != nil {
+					return err
+				}
+				payloads[i].Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetSupplyDecrease{
+					nil,
+					extraJson.AssetSupplyPublicKey,
+					extraJson.AssetSignature,
+				}
+			case transaction_zether_payload_script.SCRIPT_ASSET_ADMIN:
+				extraJson := &json_Only_TransactionZetherPayloadExtraAssetAdmin{}
+				if err = json.Unmarshal(data, extraJson); err != nil {
+					return err
+				}
+				payloads[i].Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetAdmin{
+					nil,
+					extraJson.AssetId,
+					extraJson.Paused,
+					extraJson.Freeze,
+					extraJson.AssetUpdatePublicKey,
+					extraJson.AssetSignature,
+				}
+			case transaction_zether_payload_script.SCRIPT_ASSET_UPDATE:
+				extraJson := &json_Only_TransactionZetherPayloadExtraAssetUpdate{}
+				if err = json.Unmarshal(data, extraJson); err != nil {
+					return err
+				}
+				payloads[i].Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetUpdate{
+					nil,
+					extraJson.AssetId,
+					extraJson.UpdateInfo,
+					extraJson.Description,
+					extraJson.Data,
+					extraJson.NewUpdatePublicKey,
+					extraJson.NewSupplyPublicKey,
+					extraJson.AssetUpdatePublicKey,
+					extraJson.AssetSignature,
+				}
 			case transaction_zether_payload_script.SCRIPT_PLAIN_ACCOUNT_FUND:
 				extraJson := &json_Only_TransactionZetherPayloadExtraPlainAccountFund{}
 				if err = json.Unmarshal(data, extraJson); err != nil {
@@ -7,6 +7,7 @@ import (
 	"pandora-pay/blockchain/data_storage"
 	"pandora-pay/blockchain/data_storage/accounts"
 	"pandora-pay/blockchain/data_storage/accounts/account"
+	"pandora-pay/blockchain/data_storage/assets/asset"
 	"pandora-pay/blockchain/data_storage/registrations/registration"
 	"pandora-pay/blockchain/transactions/transaction/transaction_data"
 	"pandora-pay/blockchain/transactions/transaction/transaction_zether/transaction_zether_payload/transaction_zether_payload_extra"
@@ -103,6 +104,18 @@ func (payload *TransactionZetherPayload) IncludePayload(txHash []byte, payloadIn
 	var balance *crypto.ElGamal
 
 	if !bytes.Equal(payload.Asset, config_coins.NATIVE_ASSET_FULL) {
+
+		var ast *asset.Asset
+		if ast, err = dataStorage.Asts.Get(string(payload.Asset)); err != nil {
+			return
+		}
+		if ast == nil {
+			return errors.New("Asset was not found")
+		}
+		if ast.Paused {
+			return errors.New("Asset is paused")
+		}
+
 		if err = payload.processAssetFee(payload.Asset, payload.Statement.Fee, payload.FeeRate, payload.FeeLeadingZeros, blockHeight, dataStorage); err != nil {
 			return
 		}
@@ -278,7 +291,7 @@ func (payload *TransactionZetherPayload) Validate(payloadIndex byte) (err error)
 
 	switch payload.PayloadScript {
 	case transaction_zether_payload_script.SCRIPT_TRANSFER:
-	case transaction_zether_payload_script.SCRIPT_STAKING, transaction_zether_payload_script.SCRIPT_STAKING_REWARD, transaction_zether_payload_script.SCRIPT_SPEND, transaction_zether_payload_script.SCRIPT_ASSET_CREATE, transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_INCREASE, transaction_zether_payload_script.SCRIPT_PLAIN_ACCOUNT_FUND, transaction_zether_payload_script.SCRIPT_CONDITIONAL_PAYMENT:
+	case transaction_zether_payload_script.SCRIPT_STAKING, transaction_zether_payload_script.SCRIPT_STAKING_REWARD, transaction_zether_payload_script.SCRIPT_SPEND, transaction_zether_payload_script.SCRIPT_ASSET_CREATE, transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_INCREASE, transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE, transaction_zether_payload_script.SCRIPT_ASSET_ADMIN, transaction_zether_payload_script.SCRIPT_ASSET_UPDATE, transaction_zether_payload_script.SCRIPT_PLAIN_ACCOUNT_FUND, transaction_zether_payload_script.SCRIPT_CONDITIONAL_PAYMENT:
 		if payload.Extra == nil {
 			return errors.New("extra is not assigned")
 		}
@@ -351,6 +364,12 @@ func (payload *TransactionZetherPayload) Deserialize(r *advanced_buffers.BufferR
 		payload.Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetCreate{}
 	case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_INCREASE:
 		payload.Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetSupplyIncrease{}
+	case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE:
+		payload.Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetSupplyDecrease{}
+	case transaction_zether_payload_script.SCRIPT_ASSET_ADMIN:
+		payload.Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetAdmin{}
+	case transaction_zether_payload_script.SCRIPT_ASSET_UPDATE:
+		payload.Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetUpdate{}
 	case transaction_zether_payload_script.SCRIPT_PLAIN_ACCOUNT_FUND:
 		payload.Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraPlainAccountFund{}
 	case transaction_zether_payload_script.SCRIPT_SPEND:
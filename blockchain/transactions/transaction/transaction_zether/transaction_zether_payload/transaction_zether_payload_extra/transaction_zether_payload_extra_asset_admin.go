@@ -0,0 +1,129 @@
+package transaction_zether_payload_extra
+
+import (
+	"bytes"
+	"errors"
+	"pandora-pay/blockchain/data_storage"
+	"pandora-pay/blockchain/data_storage/assets/asset"
+	"pandora-pay/blockchain/transactions/transaction/transaction_zether/transaction_zether_registrations"
+	"pandora-pay/config/config_coins"
+	"pandora-pay/cryptography"
+	"pandora-pay/cryptography/crypto"
+	"pandora-pay/helpers/advanced_buffers"
+)
+
+type TransactionZetherPayloadExtraAssetAdmin struct {
+	TransactionZetherPayloadExtraInterface
+	AssetId              []byte
+	Paused               bool   //new paused state
+	Freeze               bool   //freezing is permanent
+	AssetUpdatePublicKey []byte //TODO: it can be bloomed
+	AssetSignature       []byte
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetAdmin) BeforeIncludeTxPayload(txHash []byte, payloadRegistrations *transaction_zether_registrations.TransactionZetherDataRegistrations, payloadIndex byte, payloadAsset []byte, payloadBurnValue uint64, payloadStatement *crypto.Statement, publicKeyList [][]byte, blockHeight uint64, dataStorage *data_storage.DataStorage) (err error) {
+	return
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetAdmin) AfterIncludeTxPayload(txHash []byte, payloadRegistrations *transaction_zether_registrations.TransactionZetherDataRegistrations, payloadIndex byte, payloadAsset []byte, payloadBurnValue uint64, payloadStatement *crypto.Statement, publicKeyList [][]byte, blockHeight uint64, dataStorage *data_storage.DataStorage) (err error) {
+
+	ast, err := dataStorage.Asts.Get(string(payloadExtra.AssetId))
+	if err != nil {
+		return
+	}
+
+	if ast == nil {
+		return errors.New("Asset was not found")
+	}
+
+	if !bytes.Equal(payloadExtra.AssetUpdatePublicKey, ast.UpdatePublicKey) {
+		return errors.New("Asset UpdatePublicKey is not matching")
+	}
+
+	if ast.Version != asset.ASSET_VERSION_ADMIN {
+		return errors.New("Asset version doesn't support pause and freeze")
+	}
+
+	changed := false
+
+	if payloadExtra.Paused != ast.Paused {
+		if !ast.CanPause {
+			return errors.New("Asset can not be paused")
+		}
+		ast.Paused = payloadExtra.Paused
+		changed = true
+	}
+
+	if payloadExtra.Freeze {
+		if !ast.CanFreeze {
+			return errors.New("Asset can not be frozen")
+		}
+		if ast.Frozen {
+			return errors.New("Asset is already frozen")
+		}
+		ast.Frozen = true
+		changed = true
+	}
+
+	if !changed {
+		return errors.New("Asset admin doesn't change anything")
+	}
+
+	return dataStorage.Asts.Update(string(payloadExtra.AssetId), ast)
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetAdmin) ComputeAllKeys(out map[string]bool) {
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetAdmin) VerifyExtraSignature(hashForSignature []byte, payloadStatement *crypto.Statement) bool {
+	return crypto.VerifySignature(hashForSignature, payloadExtra.AssetSignature, payloadExtra.AssetUpdatePublicKey)
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetAdmin) Validate(payloadRegistrations *transaction_zether_registrations.TransactionZetherDataRegistrations, payloadIndex byte, payloadAsset []byte, payloadBurnValue uint64, payloadStatement *crypto.Statement, payloadParity bool) error {
+	if !bytes.Equal(payloadAsset, config_coins.NATIVE_ASSET_FULL) {
+		return errors.New("payloadAsset must be NATIVE_ASSET_FULL")
+	}
+	if len(payloadExtra.AssetId) != config_coins.ASSET_LENGTH || bytes.Equal(payloadExtra.AssetId, config_coins.NATIVE_ASSET_FULL) {
+		return errors.New("Invalid AssetId")
+	}
+	if len(payloadExtra.AssetUpdatePublicKey) != cryptography.PublicKeySize {
+		return errors.New("Invalid Public Keys")
+	}
+	if len(payloadExtra.AssetSignature) != cryptography.SignatureSize {
+		return errors.New("Invalid Signature")
+	}
+	return nil
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetAdmin) Serialize(w *advanced_buffers.BufferWriter, inclSignature bool) {
+	w.Write(payloadExtra.AssetId)
+	w.WriteBool(payloadExtra.Paused)
+	w.WriteBool(payloadExtra.Freeze)
+	w.Write(payloadExtra.AssetUpdatePublicKey)
+	if inclSignature {
+		w.Write(payloadExtra.AssetSignature)
+	}
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetAdmin) Deserialize(r *advanced_buffers.BufferReader) (err error) {
+	if payloadExtra.AssetId, err = r.ReadBytes(config_coins.ASSET_LENGTH); err != nil {
+		return
+	}
+	if payloadExtra.Paused, err = r.ReadBool(); err != nil {
+		return
+	}
+	if payloadExtra.Freeze, err = r.ReadBool(); err != nil {
+		return
+	}
+	if payloadExtra.AssetUpdatePublicKey, err = r.ReadBytes(cryptography.PublicKeySize); err != nil {
+		return
+	}
+	if payloadExtra.AssetSignature, err = r.ReadBytes(cryptography.SignatureSize); err != nil {
+		return
+	}
+	return
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetAdmin) UpdateStatement(payloadStatement *crypto.Statement) error {
+	return nil
+}
@@ -0,0 +1,181 @@
+package transaction_zether_payload_extra
+
+import (
+	"bytes"
+	"errors"
+	"pandora-pay/blockchain/data_storage"
+	"pandora-pay/blockchain/transactions/transaction/transaction_zether/transaction_zether_registrations"
+	"pandora-pay/config/config_coins"
+	"pandora-pay/cryptography"
+	"pandora-pay/cryptography/crypto"
+	"pandora-pay/helpers/advanced_buffers"
+)
+
+type TransactionZetherPayloadExtraAssetUpdate struct {
+	TransactionZetherPayloadExtraInterface
+	AssetId              []byte
+	UpdateInfo           bool //description and data will be replaced
+	Description          string
+	Data                 []byte
+	NewUpdatePublicKey   []byte //empty for no change
+	NewSupplyPublicKey   []byte //empty for no change
+	AssetUpdatePublicKey []byte //TODO: it can be bloomed
+	AssetSignature       []byte
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetUpdate) BeforeIncludeTxPayload(txHash []byte, payloadRegistrations *transaction_zether_registrations.TransactionZetherDataRegistrations, payloadIndex byte, payloadAsset []byte, payloadBurnValue uint64, payloadStatement *crypto.Statement, publicKeyList [][]byte, blockHeight uint64, dataStorage *data_storage.DataStorage) (err error) {
+	return
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetUpdate) AfterIncludeTxPayload(txHash []byte, payloadRegistrations *transaction_zether_registrations.TransactionZetherDataRegistrations, payloadIndex byte, payloadAsset []byte, payloadBurnValue uint64, payloadStatement *crypto.Statement, publicKeyList [][]byte, blockHeight uint64, dataStorage *data_storage.DataStorage) (err error) {
+
+	ast, err := dataStorage.Asts.Get(string(payloadExtra.AssetId))
+	if err != nil {
+		return
+	}
+
+	if ast == nil {
+		return errors.New("Asset was not found")
+	}
+
+	if !bytes.Equal(payloadExtra.AssetUpdatePublicKey, ast.UpdatePublicKey) {
+		return errors.New("Asset UpdatePublicKey is not matching")
+	}
+
+	if payloadExtra.UpdateInfo {
+		if !ast.CanUpgrade {
+			return errors.New("Asset can not be upgraded")
+		}
+		ast.Description = payloadExtra.Description
+		ast.Data = payloadExtra.Data
+	}
+
+	if len(payloadExtra.NewUpdatePublicKey) > 0 {
+		if !ast.CanChangeUpdatePublicKey {
+			return errors.New("Asset UpdatePublicKey can not be changed")
+		}
+		ast.UpdatePublicKey = payloadExtra.NewUpdatePublicKey
+	}
+
+	if len(payloadExtra.NewSupplyPublicKey) > 0 {
+		if !ast.CanChangeSupplyPublicKey {
+			return errors.New("Asset SupplyPublicKey can not be changed")
+		}
+		ast.SupplyPublicKey = payloadExtra.NewSupplyPublicKey
+	}
+
+	if err = ast.Validate(); err != nil {
+		return
+	}
+
+	return dataStorage.Asts.Update(string(payloadExtra.AssetId), ast)
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetUpdate) ComputeAllKeys(out map[string]bool) {
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetUpdate) VerifyExtraSignature(hashForSignature []byte, payloadStatement *crypto.Statement) bool {
+	return crypto.VerifySignature(hashForSignature, payloadExtra.AssetSignature, payloadExtra.AssetUpdatePublicKey)
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetUpdate) Validate(payloadRegistrations *transaction_zether_registrations.TransactionZetherDataRegistrations, payloadIndex byte, payloadAsset []byte, payloadBurnValue uint64, payloadStatement *crypto.Statement, payloadParity bool) error {
+	if !bytes.Equal(payloadAsset, config_coins.NATIVE_ASSET_FULL) {
+		return errors.New("payloadAsset must be NATIVE_ASSET_FULL")
+	}
+	if len(payloadExtra.AssetId) != config_coins.ASSET_LENGTH || bytes.Equal(payloadExtra.AssetId, config_coins.NATIVE_ASSET_FULL) {
+		return errors.New("Invalid AssetId")
+	}
+	if !payloadExtra.UpdateInfo && len(payloadExtra.NewUpdatePublicKey) == 0 && len(payloadExtra.NewSupplyPublicKey) == 0 {
+		return errors.New("Asset update doesn't change anything")
+	}
+	if !payloadExtra.UpdateInfo && (len(payloadExtra.Description) > 0 || len(payloadExtra.Data) > 0) {
+		return errors.New("Description and Data must be empty")
+	}
+	if len(payloadExtra.Description) > 1024 {
+		return errors.New("asset description length is invalid")
+	}
+	if len(payloadExtra.Data) > 5120 {
+		return errors.New("asset data length is invalid")
+	}
+	if len(payloadExtra.NewUpdatePublicKey) != 0 && len(payloadExtra.NewUpdatePublicKey) != cryptography.PublicKeySize {
+		return errors.New("Invalid New Update Public Key")
+	}
+	if len(payloadExtra.NewSupplyPublicKey) != 0 && len(payloadExtra.NewSupplyPublicKey) != cryptography.PublicKeySize {
+		return errors.New("Invalid New Supply Public Key")
+	}
+	if len(payloadExtra.AssetUpdatePublicKey) != cryptography.PublicKeySize {
+		return errors.New("Invalid Public Keys")
+	}
+	if len(payloadExtra.AssetSignature) != cryptography.SignatureSize {
+		return errors.New("Invalid Signature")
+	}
+	return nil
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetUpdate) Serialize(w *advanced_buffers.BufferWriter, inclSignature bool) {
+	w.Write(payloadExtra.AssetId)
+	w.WriteBool(payloadExtra.UpdateInfo)
+	if payloadExtra.UpdateInfo {
+		w.WriteString(payloadExtra.Description)
+		w.WriteVariableBytes(payloadExtra.Data)
+	}
+	w.WriteBool(len(payloadExtra.NewUpdatePublicKey) > 0)
+	if len(payloadExtra.NewUpdatePublicKey) > 0 {
+		w.Write(payloadExtra.NewUpdatePublicKey)
+	}
+	w.WriteBool(len(payloadExtra.NewSupplyPublicKey) > 0)
+	if len(payloadExtra.NewSupplyPublicKey) > 0 {
+		w.Write(payloadExtra.NewSupplyPublicKey)
+	}
+	w.Write(payloadExtra.AssetUpdatePublicKey)
+	if inclSignature {
+		w.Write(payloadExtra.AssetSignature)
+	}
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetUpdate) Deserialize(r *advanced_buffers.BufferReader) (err error) {
+	if payloadExtra.AssetId, err = r.ReadBytes(config_coins.ASSET_LENGTH); err != nil {
+		return
+	}
+	if payloadExtra.UpdateInfo, err = r.ReadBool(); err != nil {
+		return
+	}
+	if payloadExtra.UpdateInfo {
+		if payloadExtra.Description, err = r.ReadString(1024); err != nil {
+			return
+		}
+		if payloadExtra.Data, err = r.ReadVariableBytes(5120); err != nil {
+			return
+		}
+	}
+
+	var hasKey bool
+	if hasKey, err = r.ReadBool(); err != nil {
+		return
+	}
+	if hasKey {
+		if payloadExtra.NewUpdatePublicKey, err = r.ReadBytes(cryptography.PublicKeySize); err != nil {
+			return
+		}
+	}
+	if hasKey, err = r.ReadBool(); err != nil {
+		return
+	}
+	if hasKey {
+		if payloadExtra.NewSupplyPublicKey, err = r.ReadBytes(cryptography.PublicKeySize); err != nil {
+			return
+		}
+	}
+
+	if payloadExtra.AssetUpdatePublicKey, err = r.ReadBytes(cryptography.PublicKeySize); err != nil {
+		return
+	}
+	if payloadExtra.AssetSignature, err = r.ReadBytes(cryptography.SignatureSize); err != nil {
+		return
+	}
+	return
+}
+
+func (payloadExtra *TransactionZetherPayloadExtraAssetUpdate) UpdateStatement(payloadStatement *crypto.Statement) error {
+	return nil
+}
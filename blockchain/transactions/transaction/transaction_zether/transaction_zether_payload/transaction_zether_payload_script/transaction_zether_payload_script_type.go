@@ -11,6 +11,9 @@ const (
 	SCRIPT_ASSET_SUPPLY_INCREASE
 	SCRIPT_PLAIN_ACCOUNT_FUND
 	SCRIPT_CONDITIONAL_PAYMENT
+	SCRIPT_ASSET_SUPPLY_DECREASE
+	SCRIPT_ASSET_ADMIN
+	SCRIPT_ASSET_UPDATE
 )
 
 func (t PayloadScriptType) String() string {
@@ -31,6 +34,12 @@ func (t PayloadScriptType) String() string {
 		return "SCRIPT_PLAIN_ACCOUNT_FUND"
 	case SCRIPT_CONDITIONAL_PAYMENT:
 		return "SCRIPT_CONDITIONAL_PAYMENT"
+	case SCRIPT_ASSET_SUPPLY_DECREASE:
+		return "SCRIPT_ASSET_SUPPLY_DECREASE"
+	case SCRIPT_ASSET_ADMIN:
+		return "SCRIPT_ASSET_ADMIN"
+	case SCRIPT_ASSET_UPDATE:
+		return "SCRIPT_ASSET_UPDATE"
 	default:
 		return "Unknown ScriptType"
 	}
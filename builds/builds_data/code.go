@@ -44,6 +44,12 @@ func PrepareData(data []byte) (txData *TransactionsBuilderCreateZetherTxReq, tra
 			txData.Payloads[t].Extra = &wizard.WizardZetherPayloadExtraAssetCreate{}
 		case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_INCREASE:
 			txData.Payloads[t].Extra = &wizard.WizardZetherPayloadExtraAssetSupplyIncrease{}
+		case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE:
+			txData.Payloads[t].Extra = &wizard.WizardZetherPayloadExtraAssetSupplyDecrease{}
+		case transaction_zether_payload_script.SCRIPT_ASSET_ADMIN:
+			txData.Payloads[t].Extra = &wizard.WizardZetherPayloadExtraAssetAdmin{}
+		case transaction_zether_payload_script.SCRIPT_ASSET_UPDATE:
+			txData.Payloads[t].Extra = &wizard.WizardZetherPayloadExtraAssetUpdate{}
 		case transaction_zether_payload_script.SCRIPT_PLAIN_ACCOUNT_FUND:
 			txData.Payloads[t].Extra = &wizard.WizardZetherPayloadExtraPlainAccountFund{}
 		case transaction_zether_payload_script.SCRIPT_CONDITIONAL_PAYMENT:
@@ -52,6 +52,9 @@ func initialize() {
 				"importWalletJSON":        js.FuncOf(importWalletJSON),
 				"exportWalletJSON":        js.FuncOf(exportWalletJSON),
 				"importWalletAddressJSON": js.FuncOf(importWalletAddressJSON),
+				"getWalletContacts":       js.FuncOf(getWalletContacts),
+				"addWalletContact":        js.FuncOf(addWalletContact),
+				"removeWalletContact":     js.FuncOf(removeWalletContact),
 				"encryption": js.ValueOf(map[string]any{
 					"checkPasswordWallet":    js.FuncOf(checkPasswordWallet),
 					"encryptWallet":          js.FuncOf(encryptWallet),
@@ -87,6 +90,7 @@ func initialize() {
 			"ripemd":               js.FuncOf(ripemd),
 			"sign":                 js.FuncOf(sign),
 			"verify":               js.FuncOf(verify),
+			"verifyMerkleProof":    js.FuncOf(verifyMerkleProof),
 		}),
 		"network": js.ValueOf(map[string]any{
 			"networkDisconnect":                      js.FuncOf(networkDisconnect),
@@ -100,6 +104,7 @@ func initialize() {
 			"getNetworkBlockWithTxs":                 js.FuncOf(getNetworkBlockWithTxs),
 			"getNetworkTx":                           js.FuncOf(getNetworkTx),
 			"getNetworkTxExists":                     js.FuncOf(getNetworkTxExists),
+			"getNetworkTxMerkleProof":                js.FuncOf(getNetworkTxMerkleProof),
 			"getNetworkBlockExists":                  js.FuncOf(getNetworkBlockExists),
 			"getNetworkTxPreview":                    js.FuncOf(getNetworkTxPreview),
 			"getNetworkAccount":                      js.FuncOf(getNetworkAccount),
@@ -109,6 +114,7 @@ func initialize() {
 			"getNetworkAssetInfo":                    js.FuncOf(getNetworkAssetInfo),
 			"getNetworkAsset":                        js.FuncOf(getNetworkAsset),
 			"getNetworkMempool":                      js.FuncOf(getNetworkMempool),
+			"getNetworkMempoolFeeEstimate":           js.FuncOf(getNetworkMempoolFeeEstimate),
 			"postNetworkMempoolBroadcastTransaction": js.FuncOf(postNetworkMempoolBroadcastTransaction),
 			"getNetworkFeeLiquidity":                 js.FuncOf(getNetworkFeeLiquidity),
 			"subscribeNetwork":                       js.FuncOf(subscribeNetwork),
@@ -151,6 +157,9 @@ func initialize() {
 						"SCRIPT_ASSET_SUPPLY_INCREASE": js.ValueOf(uint64(transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_INCREASE)),
 						"SCRIPT_PLAIN_ACCOUNT_FUND":    js.ValueOf(uint64(transaction_zether_payload_script.SCRIPT_PLAIN_ACCOUNT_FUND)),
 						"SCRIPT_CONDITIONAL_PAYMENT":   js.ValueOf(uint64(transaction_zether_payload_script.SCRIPT_CONDITIONAL_PAYMENT)),
+						"SCRIPT_ASSET_SUPPLY_DECREASE": js.ValueOf(uint64(transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE)),
+						"SCRIPT_ASSET_ADMIN":           js.ValueOf(uint64(transaction_zether_payload_script.SCRIPT_ASSET_ADMIN)),
+						"SCRIPT_ASSET_UPDATE":          js.ValueOf(uint64(transaction_zether_payload_script.SCRIPT_ASSET_UPDATE)),
 					}),
 				}),
 			}),
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"bytes"
 	"encoding/base64"
 	"pandora-pay/builds/webassembly/webassembly_utils"
 	"pandora-pay/cryptography"
 	"pandora-pay/cryptography/crypto"
+	"pandora-pay/cryptography/merkle_tree"
+	"pandora-pay/network/api_implementation/api_common"
 	"syscall/js"
 )
 
@@ -81,3 +84,35 @@ func verify(this js.Value, args []js.Value) interface{} {
 		return out, nil
 	})
 }
+
+func verifyMerkleProof(this js.Value, args []js.Value) interface{} {
+	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
+
+		txHash, err := base64.StdEncoding.DecodeString(args[0].String())
+		if err != nil {
+			return nil, err
+		}
+
+		//merkle hash of the block header that the wallet trusts
+		merkleHash, err := base64.StdEncoding.DecodeString(args[1].String())
+		if err != nil {
+			return nil, err
+		}
+
+		proof := &api_common.APITxMerkleProofReply{}
+		if err = webassembly_utils.UnmarshalBytes(args[2], proof); err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(proof.MerkleHash, merkleHash) {
+			return false, nil
+		}
+
+		hashes := make([][]byte, len(proof.Proof))
+		for i := range proof.Proof {
+			hashes[i] = proof.Proof[i]
+		}
+
+		return merkle_tree.VerifyMerkleProof(merkleHash, txHash, proof.Index, hashes), nil
+	})
+}
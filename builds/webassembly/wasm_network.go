@@ -276,6 +276,23 @@ func getNetworkTxExists(this js.Value, args []js.Value) interface{} {
 	})
 }
 
+func getNetworkTxMerkleProof(this js.Value, args []js.Value) interface{} {
+	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
+
+		request := &api_common.APITxMerkleProofRequest{}
+		if err := webassembly_utils.UnmarshalBytes(args[0], request); err != nil {
+			return nil, err
+		}
+
+		received, err := network.SendJSONAwaitAnswer[api_common.APITxMerkleProofReply]([]byte("tx/merkle-proof"), request, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return webassembly_utils.ConvertJSONBytes(received)
+	})
+}
+
 func getNetworkBlockExists(this js.Value, args []js.Value) interface{} {
 	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
 
@@ -354,6 +371,12 @@ func getNetworkMempool(this js.Value, args []js.Value) interface{} {
 	})
 }
 
+func getNetworkMempoolFeeEstimate(this js.Value, args []js.Value) interface{} {
+	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
+		return webassembly_utils.ConvertToJSONBytes(network.SendJSONAwaitAnswer[api_common.APIMempoolFeeEstimateReply]([]byte("mempool/fee-estimate"), nil, nil, 0))
+	})
+}
+
 func postNetworkMempoolBroadcastTransaction(this js.Value, args []js.Value) interface{} {
 	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
 
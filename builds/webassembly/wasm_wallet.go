@@ -7,6 +7,7 @@ import (
 	"pandora-pay/builds/webassembly/webassembly_utils"
 	"pandora-pay/helpers"
 	"pandora-pay/helpers/advanced_buffers"
+	"pandora-pay/wallet"
 	"syscall/js"
 )
 
@@ -181,6 +182,36 @@ func importWalletSecretKey(this js.Value, args []js.Value) interface{} {
 	})
 }
 
+func getWalletContacts(this js.Value, args []js.Value) interface{} {
+	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
+		return webassembly_utils.ConvertJSONBytes(app.Wallet.GetContacts())
+	})
+}
+
+func addWalletContact(this js.Value, args []js.Value) interface{} {
+	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
+		if err := app.Wallet.Encryption.CheckPassword(args[0].String(), false); err != nil {
+			return nil, err
+		}
+
+		contact := &wallet.WalletContact{}
+		if err := webassembly_utils.UnmarshalBytes(args[1], contact); err != nil {
+			return nil, err
+		}
+
+		return true, app.Wallet.AddContact(contact)
+	})
+}
+
+func removeWalletContact(this js.Value, args []js.Value) interface{} {
+	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
+		if err := app.Wallet.Encryption.CheckPassword(args[0].String(), false); err != nil {
+			return nil, err
+		}
+		return app.Wallet.RemoveContact(args[1].String())
+	})
+}
+
 func importWalletJSON(this js.Value, args []js.Value) interface{} {
 	return webassembly_utils.PromiseFunction(func() (interface{}, error) {
 		if err := app.Wallet.Encryption.CheckPassword(args[0].String(), false); err != nil {
@@ -16,9 +16,18 @@ import (
 )
 
 func broadcastChain(newChainData *blockchain.BlockchainData, ctxDuration time.Duration) {
-	websocks.Websockets.BroadcastJSON([]byte("chain-update"), node_http.HttpServer.ApiWebsockets.Consensus.GetUpdateNotification(newChainData), map[config.NodeConsensusType]bool{config.NODE_CONSENSUS_TYPE_FULL: true, config.NODE_CONSENSUS_TYPE_APP: true}, advanced_connection_types.UUID_ALL, ctxDuration)
+
+	notification := node_http.HttpServer.ApiWebsockets.Consensus.GetUpdateNotification(newChainData)
+	websocks.Websockets.BroadcastJSON([]byte("chain-update"), notification, map[config.NodeConsensusType]bool{config.NODE_CONSENSUS_TYPE_APP: true}, advanced_connection_types.UUID_ALL, ctxDuration)
+
+	//full nodes receive the compact block of the tip to rebuild it from their mempool
+	notificationCompact := *notification
+	notificationCompact.Compact, _ = node_http.HttpServer.ApiWebsockets.Consensus.OpenLoadBlockCompact(newChainData.Hash)
+	websocks.Websockets.BroadcastJSON([]byte("chain-update"), &notificationCompact, map[config.NodeConsensusType]bool{config.NODE_CONSENSUS_TYPE_FULL: true}, advanced_connection_types.UUID_ALL, ctxDuration)
 }
 
+var inventory = newTxsInventory()
+
 func BroadcastTxs(txs []*transaction.Transaction, justCreated, awaitPropagation bool, exceptSocketUUID advanced_connection_types.UUID, ctxParent context.Context) []error {
 
 	errs := make([]error, len(txs))
@@ -59,8 +68,9 @@ func BroadcastTxs(txs []*transaction.Transaction, justCreated, awaitPropagation
 						errs[i] = o.Err
 					}
 				}
-			} else {
-				websocks.Websockets.Broadcast([]byte("mempool/new-tx-id"), tx.Bloom.Hash, map[config.NodeConsensusType]bool{config.NODE_CONSENSUS_TYPE_FULL: true}, exceptSocketUUID, 0)
+			} else if exceptSocketUUID != advanced_connection_types.UUID_SKIP_ALL {
+				//announced in batches, the peers download only the txs they are missing
+				inventory.add(tx.Bloom.Hash, exceptSocketUUID)
 			}
 		}
 
@@ -71,6 +81,8 @@ func BroadcastTxs(txs []*transaction.Transaction, justCreated, awaitPropagation
 
 func initializeConsensus(chain *blockchain.Blockchain, mempool *mempool.Mempool) {
 
+	recovery.SafeGo(inventory.run)
+
 	recovery.SafeGo(func() {
 
 		updateNewChainUpdateListener := chain.UpdateNewChainDataUpdate.AddListener()
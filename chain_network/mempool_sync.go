@@ -20,7 +20,7 @@ func DownloadMempool(conn *connection.AdvancedConnection) (err error) {
 	for {
 
 		var data *api_common.APIMempoolReply
-		if data, err = connection.SendJSONAwaitAnswer[api_common.APIMempoolReply](conn, []byte("mempool"), &api_common.APIMempoolRequest{chainHash, page, 0}, nil, 0); err != nil {
+		if data, err = connection.SendJSONAwaitAnswer[api_common.APIMempoolReply](conn, []byte("mempool"), &api_common.APIMempoolRequest{chainHash, page, 0, false}, nil, 0); err != nil {
 			return
 		}
 
@@ -0,0 +1,92 @@
+package chain_network
+
+import (
+	"pandora-pay/config"
+	"pandora-pay/network/api_implementation/api_common"
+	"pandora-pay/network/network_config"
+	"pandora-pay/network/websocks"
+	"pandora-pay/network/websocks/connection"
+	"pandora-pay/network/websocks/connection/advanced_connection_types"
+	"sync"
+	"time"
+)
+
+// txsInventory aggregates the hashes of the new txs and announces them in batches. Peers without inv support receive the hashes one by one
+type txsInventory struct {
+	pending map[advanced_connection_types.UUID][][]byte
+	count   int
+	sync.Mutex
+}
+
+func (inventory *txsInventory) add(hash []byte, exceptSocketUUID advanced_connection_types.UUID) {
+
+	inventory.Lock()
+	inventory.pending[exceptSocketUUID] = append(inventory.pending[exceptSocketUUID], hash)
+	inventory.count += 1
+	full := inventory.count >= network_config.WEBSOCKETS_TXS_INVENTORY_MAX
+	inventory.Unlock()
+
+	if full {
+		inventory.flush()
+	}
+}
+
+func (inventory *txsInventory) flush() {
+
+	inventory.Lock()
+	pending := inventory.pending
+	inventory.pending = make(map[advanced_connection_types.UUID][][]byte)
+	inventory.count = 0
+	inventory.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	for _, conn := range websocks.Websockets.GetAllSockets() {
+
+		if conn.Handshake.Consensus != config.NODE_CONSENSUS_TYPE_FULL {
+			continue
+		}
+
+		hashes := make([][]byte, 0)
+		for exceptSocketUUID, list := range pending {
+			if exceptSocketUUID != conn.UUID {
+				hashes = append(hashes, list...)
+			}
+		}
+		if len(hashes) == 0 {
+			continue
+		}
+
+		go func(conn *connection.AdvancedConnection) {
+			if !conn.Handshake.HasFeature(network_config.WEBSOCKETS_FEATURE_MEMPOOL_INV) {
+				for _, hash := range hashes {
+					conn.Send([]byte("mempool/new-tx-id"), hash, 0)
+				}
+				return
+			}
+			for len(hashes) > 0 {
+				count := len(hashes)
+				if count > network_config.WEBSOCKETS_TXS_INVENTORY_MAX {
+					count = network_config.WEBSOCKETS_TXS_INVENTORY_MAX
+				}
+				conn.SendJSON([]byte("mempool/inv"), &api_common.APIMempoolInvRequest{hashes[:count]}, 0)
+				hashes = hashes[count:]
+			}
+		}(conn)
+	}
+}
+
+func (inventory *txsInventory) run() {
+	for {
+		time.Sleep(network_config.WEBSOCKETS_TXS_INVENTORY_DELAY)
+		inventory.flush()
+	}
+}
+
+func newTxsInventory() *txsInventory {
+	return &txsInventory{
+		pending: make(map[advanced_connection_types.UUID][][]byte),
+	}
+}
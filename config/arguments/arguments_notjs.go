@@ -8,7 +8,7 @@ package arguments
 var commands = `PANDORA PAY.
 
 Usage:
-  pandorapay [--pprof] [--network=network] [--debug] [--gui-type=type] [--forging] [--new-devnet] [--run-testnet-script] [--node-name=name] [--tcp-server-port=port] [--tcp-server-address=address] [--tcp-server-auto-tls-certificate] [--tcp-server-tls-cert-file=path] [--tcp-server-tls-key-file=path] [--instance=prefix] [--instance-id=id] [--set-genesis=genesis] [--create-new-genesis=args] [--store-wallet-type=type] [--store-chain-type=type] [--node-consensus=type] [--tcp-max-clients=limit] [--tcp-max-server-sockets=limit] [--node-provide-extended-info-app=bool] [--wallet-encrypt=args] [--wallet-decrypt=password] [--wallet-remove-encryption] [--wallet-export-shared-staked-address=args] [--wallet-import-secret-mnemonic=mnemonic] [--wallet-import-secret-entropy=entropy] [--hcaptcha-secret=args] [--faucet-testnet-enabled=args] [--delegator-enabled=bool] [--delegator-require-auth=bool] [--delegates-maximum=args] [--auth-users=args] [--light-computations] [--balance-decryptor-disable-init] [--balance-decryptor-table-size=size] [--tcp-connections-ready=threshold] [--exit] [--skip-init-sync] [--tcp-server-url=url]
+  pandorapay [--pprof] [--network=network] [--debug] [--gui-type=type] [--forging] [--new-devnet] [--run-testnet-script] [--node-name=name] [--tcp-server-port=port] [--tcp-server-address=address] [--tcp-server-auto-tls-certificate] [--tcp-server-tls-cert-file=path] [--tcp-server-tls-key-file=path] [--instance=prefix] [--instance-id=id] [--set-genesis=genesis] [--create-new-genesis=args] [--store-wallet-type=type] [--store-chain-type=type] [--store-chain-migrate=type] [--prune=blocks] [--prune-txs] [--import-snapshot=path] [--import-snapshot-hash=hash] [--mempool-max-size=bytes] [--mempool-max-txs-per-account=count] [--mempool-max-age=blocks] [--node-consensus=type] [--tcp-max-clients=limit] [--tcp-max-server-sockets=limit] [--node-provide-extended-info-app=bool] [--wallet-encrypt=args] [--wallet-decrypt=password] [--wallet-remove-encryption] [--wallet-export-shared-staked-address=args] [--wallet-import-secret-mnemonic=mnemonic] [--wallet-import-secret-entropy=entropy] [--hcaptcha-secret=args] [--faucet-testnet-enabled=args] [--delegator-enabled=bool] [--delegator-require-auth=bool] [--delegates-maximum=args] [--auth-users=args] [--light-computations] [--balance-decryptor-disable-init] [--balance-decryptor-table-size=size] [--tcp-connections-ready=threshold] [--exit] [--skip-init-sync] [--tcp-server-url=url]
   pandorapay -h | --help
   pandorapay -v | --version
 
@@ -24,8 +24,16 @@ Options:
   --run-testnet-script                               Run testnet script which will create dummy transactions in the network.
   --set-genesis=genesis                              Manually set the Genesis via a JSON. By using argument "file" it will read it via a file.
   --create-new-genesis=args                          Create a new Genesis. Useful for creating a new private testnet. Argument must be "0.stake,1.stake,2.stake"
-  --store-wallet-type=type                           Set Wallet Store Type. Accepted values: "bolt|bunt|bunt-memory|memory". [default: bolt]
-  --store-chain-type=type                            Set Chain Store Type. Accepted values: "bolt|bunt|bunt-memory|memory".  [default: bolt]
+  --store-wallet-type=type                           Set Wallet Store Type. Accepted values: "bolt|bunt|bunt-memory|memory|leveldb". [default: bolt]
+  --store-chain-type=type                            Set Chain Store Type. Accepted values: "bolt|bunt|bunt-memory|memory|leveldb".  [default: bolt]
+  --store-chain-migrate=type                         Copy an existing Chain Store of the given type (e.g. "bolt") into the --store-chain-type store. The target store must be empty.
+  --prune=blocks                                     Keep only the rollback data of the last given number of blocks. Headers and current state are kept.
+  --prune-txs                                        Drop the transactions of the pruned blocks as well. It requires --prune.
+  --import-snapshot=path                             Bootstrap an empty chain store from a state snapshot file and sync the remaining blocks normally. It requires --import-snapshot-hash.
+  --import-snapshot-hash=hash                        Trusted base64 hash of the block at the snapshot height. The snapshot state must match the PrevStateHash of this block.
+  --mempool-max-size=bytes                           Maximum size of the mempool. The txs with the lowest fee per byte are evicted first.
+  --mempool-max-txs-per-account=count                Maximum number of pending simple txs of a sender.
+  --mempool-max-age=blocks                           Txs that were not included after the given number of blocks are evicted. Use 0 to disable it.
   --forging                                          Start Forging blocks.
   --node-name=name                                   Change node name.
   --node-consensus=type                              Consensus type. Accepted values: "full|app|none" [default: full].
@@ -9,6 +9,7 @@ import (
 	"pandora-pay/config/config_forging"
 	"pandora-pay/config/config_nodes"
 	"runtime"
+	"strconv"
 	"time"
 )
 
@@ -28,6 +29,8 @@ var (
 const (
 	TRANSACTIONS_MAX_DATA_LENGTH = 512
 	TRANSACTIONS_ZETHER_RING_MAX = 256
+
+	TRANSACTIONS_ZETHER_BATCH_MAX_SIZE uint64 = 128 * 1024 //bytes of a batch transaction
 )
 
 const (
@@ -46,11 +49,13 @@ const (
 )
 
 const (
-	BLOCK_MAX_SIZE          uint64 = 1024 * 1024
-	BLOCK_TIME              uint64 = 90 //seconds
-	DIFFICULTY_BLOCK_WINDOW uint64 = 10
-	FORK_MAX_UNCLE_ALLOWED  uint64 = 60
-	FORK_MAX_DOWNLOAD       uint64 = 20
+	BLOCK_MAX_SIZE            uint64 = 1024 * 1024
+	BLOCK_TIME                uint64 = 90 //seconds
+	DIFFICULTY_BLOCK_WINDOW   uint64 = 10
+	FORK_MAX_UNCLE_ALLOWED    uint64 = 60
+	FORK_MAX_DOWNLOAD         uint64 = 20
+	FORK_MAX_DOWNLOAD_HEADERS uint64 = 1000
+	FORK_DOWNLOAD_PARALLEL           = 5
 )
 
 var (
@@ -65,6 +70,7 @@ var (
 	API_MEMPOOL_MAX_TRANSACTIONS = 50
 	API_ACCOUNT_MAX_TXS          = uint64(10)
 	API_ASSETS_INFO_MAX_RESULTS  = 10
+	API_WALLET_MAX_HISTORY       = 50
 )
 
 var (
@@ -79,6 +85,21 @@ var (
 	NODE_CONSENSUS                 NodeConsensusType = NODE_CONSENSUS_TYPE_FULL
 )
 
+var (
+	PRUNE_BLOCKS                uint64 //0 means that pruning is disabled
+	PRUNE_TXS                   bool
+	PRUNE_MAX_BLOCKS_PER_UPDATE uint64 = 1000
+)
+
+var (
+	MEMPOOL_MAX_SIZE            uint64 = 200 * 1024 * 1024 //bytes
+	MEMPOOL_MAX_TXS_PER_ACCOUNT uint64 = 100
+	MEMPOOL_MAX_AGE_BLOCKS      uint64 = 960 //0 means that txs never expire
+
+	MEMPOOL_REPLACE_BY_FEE_MIN_INCREASE uint64 = 10 //percentage of the fee per byte
+	MEMPOOL_FEE_ESTIMATE_BLOCKS         uint64 = 20
+)
+
 var (
 	INSTANCE    = ""
 	INSTANCE_ID = 0
@@ -127,6 +148,39 @@ func InitConfig() (err error) {
 		return errors.New("invalid consensus argument")
 	}
 
+	if arguments.Arguments["--prune"] != nil {
+		if PRUNE_BLOCKS, err = strconv.ParseUint(arguments.Arguments["--prune"].(string), 10, 64); err != nil {
+			return errors.New("--prune is invalid")
+		}
+		if PRUNE_BLOCKS <= FORK_MAX_UNCLE_ALLOWED {
+			return errors.New("--prune must be greater than " + strconv.FormatUint(FORK_MAX_UNCLE_ALLOWED, 10))
+		}
+		if arguments.Arguments["--prune-txs"] == true {
+			PRUNE_TXS = true
+		}
+	} else if arguments.Arguments["--prune-txs"] == true {
+		return errors.New("--prune-txs requires --prune")
+	}
+
+	if arguments.Arguments["--mempool-max-size"] != nil {
+		if MEMPOOL_MAX_SIZE, err = strconv.ParseUint(arguments.Arguments["--mempool-max-size"].(string), 10, 64); err != nil {
+			return errors.New("--mempool-max-size is invalid")
+		}
+		if MEMPOOL_MAX_SIZE < BLOCK_MAX_SIZE {
+			return errors.New("--mempool-max-size must be at least " + strconv.FormatUint(BLOCK_MAX_SIZE, 10))
+		}
+	}
+	if arguments.Arguments["--mempool-max-txs-per-account"] != nil {
+		if MEMPOOL_MAX_TXS_PER_ACCOUNT, err = strconv.ParseUint(arguments.Arguments["--mempool-max-txs-per-account"].(string), 10, 64); err != nil || MEMPOOL_MAX_TXS_PER_ACCOUNT == 0 {
+			return errors.New("--mempool-max-txs-per-account is invalid")
+		}
+	}
+	if arguments.Arguments["--mempool-max-age"] != nil {
+		if MEMPOOL_MAX_AGE_BLOCKS, err = strconv.ParseUint(arguments.Arguments["--mempool-max-age"].(string), 10, 64); err != nil {
+			return errors.New("--mempool-max-age is invalid")
+		}
+	}
+
 	if err = config_nodes.InitConfig(); err != nil {
 		return
 	}
@@ -1,13 +1,15 @@
 package merkle_tree
 
 import (
+	"bytes"
+	"errors"
 	"math"
 	"pandora-pay/cryptography"
 )
 
 /**
 Fast Merkle Tree Construction
-It can't generate efficient proofs
+Inclusion proofs are generated by walking the levels of the tree
 */
 
 func roundNextPowerOfTwo(number int) int {
@@ -22,7 +24,9 @@ func roundNextPowerOfTwo(number int) int {
 
 func hashMerkleNode(left []byte, right []byte) []byte {
 	// Concatenate the left and right nodes.
-	hash := append(left, right...)
+	hash := make([]byte, 0, len(left)+len(right))
+	hash = append(hash, left...)
+	hash = append(hash, right...)
 	return cryptography.SHA3(hash)
 }
 
@@ -65,3 +69,49 @@ func MerkleRoot(hashes [][]byte) []byte {
 	merkles := buildMerkleTree(hashes)
 	return merkles[len(merkles)-1] //return last element
 }
+
+// MerkleProof returns the sibling hashes from the leaf up to the root
+func MerkleProof(hashes [][]byte, index int) ([][]byte, error) {
+
+	if index < 0 || index >= len(hashes) {
+		return nil, errors.New("Invalid merkle leaf index")
+	}
+
+	nodes := buildMerkleTree(hashes)
+
+	proof := make([][]byte, 0)
+
+	start, width := 0, roundNextPowerOfTwo(len(hashes))
+	for width > 1 {
+		sibling := nodes[start+(index^1)]
+		if sibling == nil { //missing right node is hashed with itself
+			sibling = nodes[start+index]
+		}
+		proof = append(proof, sibling)
+
+		start += width
+		width /= 2
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+func VerifyMerkleProof(root, leaf []byte, index uint64, proof [][]byte) bool {
+
+	if len(proof) >= 64 || index >= 1<<uint(len(proof)) {
+		return false
+	}
+
+	hash := leaf
+	for _, sibling := range proof {
+		if index&1 == 0 {
+			hash = hashMerkleNode(hash, sibling)
+		} else {
+			hash = hashMerkleNode(sibling, hash)
+		}
+		index /= 2
+	}
+
+	return bytes.Equal(hash, root)
+}
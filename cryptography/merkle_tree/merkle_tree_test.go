@@ -18,3 +18,30 @@ func TestMerkleRoot(t *testing.T) {
 	assert.Equal(t, root, hash, "Merkle Tree Hashes are invalid")
 
 }
+
+func TestMerkleProof(t *testing.T) {
+
+	for count := 1; count < 20; count++ {
+
+		hashes := make([][]byte, count)
+		for i := range hashes {
+			hashes[i] = cryptography.RandomHash()
+		}
+
+		root := MerkleRoot(hashes)
+
+		for i := range hashes {
+			proof, err := MerkleProof(hashes, i)
+			assert.NoError(t, err)
+			assert.True(t, VerifyMerkleProof(root, hashes[i], uint64(i), proof), "Merkle Proof is invalid")
+			assert.False(t, VerifyMerkleProof(root, cryptography.RandomHash(), uint64(i), proof), "Merkle Proof should be invalid")
+			if count > 1 {
+				assert.False(t, VerifyMerkleProof(root, hashes[i], uint64((i+1)%count), proof), "Merkle Proof should be invalid")
+			}
+		}
+
+		_, err := MerkleProof(hashes, count)
+		assert.Error(t, err)
+	}
+
+}
@@ -15,6 +15,7 @@ require (
 	github.com/rs/cors v1.8.2
 	github.com/stretchr/testify v1.7.0
 	github.com/tevino/abool v1.2.0
+	github.com/syndtr/goleveldb v1.0.0
 	github.com/tidwall/buntdb v1.2.3
 	github.com/tyler-smith/go-bip32 v1.0.0
 	github.com/tyler-smith/go-bip39 v1.1.0
@@ -32,6 +33,7 @@ require (
 	github.com/codemodus/kace v0.5.1 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/docopt/docopt-go v0.0.0-20180111231733-ee0de3bc6815 // indirect
+	github.com/golang/snappy v0.0.0-20180518054509-2e65f85255db // indirect
 	github.com/klauspost/compress v1.10.3 // indirect
 	github.com/mattn/go-runewidth v0.0.2 // indirect
 	github.com/mitchellh/go-wordwrap v0.0.0-20150314170334-ad45545899c7 // indirect
@@ -37,6 +37,7 @@ type Command struct {
 
 var commands = []Command{
 	{Name: "Wallet", Text: "List Addresses"},
+	{Name: "Wallet", Text: "Show History"},
 	{Name: "Wallet", Text: "Create New Address"},
 	{Name: "Wallet", Text: "Clear & Create new empty Wallet"},
 	{Name: "Wallet", Text: "Show Mnemnonic"},
@@ -47,11 +48,21 @@ var commands = []Command{
 	{Name: "Wallet", Text: "Import Address Secret Key"},
 	{Name: "Wallet", Text: "Remove Address"},
 	{Name: "Wallet", Text: "Export Staked Staked Address"},
+	{Name: "Wallet", Text: "List Contacts"},
+	{Name: "Wallet", Text: "Add Contact"},
+	{Name: "Wallet", Text: "Remove Contact"},
 	{Name: "Wallet:TX", Text: "Private Transfer"},
+	{Name: "Wallet:TX", Text: "Private Transfer Batch"},
+	{Name: "Wallet:TX", Text: "Private Transfer Offline Request"},
+	{Name: "Wallet:TX", Text: "Sign Offline Transaction Request"},
+	{Name: "Wallet:TX", Text: "Broadcast Signed Transaction"},
 	{Name: "Wallet:TX", Text: "Private Delegate Stake"},
 	{Name: "Wallet:TX", Text: "Private Claim"},
 	{Name: "Wallet:TX", Text: "Private Asset Create"},
 	{Name: "Wallet:TX", Text: "Private Asset Supply Increase"},
+	{Name: "Wallet:TX", Text: "Private Asset Supply Decrease"},
+	{Name: "Wallet:TX", Text: "Private Asset Admin"},
+	{Name: "Wallet:TX", Text: "Private Asset Update"},
 	{Name: "Wallet:TX", Text: "Private Plain Account Fund"},
 	{Name: "Wallet:TX", Text: "Private Conditional Payment"},
 	{Name: "Wallet:TX", Text: "Public Update Asset Fee Liquidity"},
@@ -59,6 +70,8 @@ var commands = []Command{
 	{Name: "Wallet", Text: "Export Addresses"},
 	{Name: "Wallet", Text: "Export Address JSON"},
 	{Name: "Wallet", Text: "Import Address JSON"},
+	{Name: "Wallet", Text: "Export View Key"},
+	{Name: "Wallet", Text: "Import View Key"},
 	{Name: "Wallet", Text: "Export Wallet JSON"},
 	{Name: "Wallet", Text: "Import Wallet JSON"},
 	{Name: "Wallet", Text: "Encrypt Wallet"},
@@ -68,6 +81,12 @@ var commands = []Command{
 	{Name: "Utils", Text: "Sign message using PrivateKey"},
 	{Name: "Utils", Text: "Sign Resolution Conditional Payment"},
 	{Name: "Mempool", Text: "Show Txs"},
+	{Name: "Chain", Text: "Export Snapshot"},
+	{Name: "Network", Text: "List Peers"},
+	{Name: "Network", Text: "Connect Peer"},
+	{Name: "Network", Text: "Disconnect Peer"},
+	{Name: "Network", Text: "Ban Peer"},
+	{Name: "Network", Text: "Unban Peer"},
 	{Name: "App", Text: "Exit"},
 }
 var commandsLock sync.Mutex
@@ -10,7 +10,6 @@ import (
 	"pandora-pay/blockchain/transactions/transaction/transaction_zether/transaction_zether_payload/transaction_zether_payload_script"
 	"pandora-pay/config/config_fees"
 	"pandora-pay/gui"
-	"pandora-pay/helpers"
 	"pandora-pay/helpers/generics"
 	"pandora-pay/helpers/recovery"
 	"pandora-pay/network/websocks/connection/advanced_connection_types"
@@ -23,6 +22,7 @@ type mempoolTx struct {
 	Tx          *transaction.Transaction `json:"tx" msgpack:"tx"`
 	Added       int64                    `json:"added" msgpack:"added"`
 	Mine        bool                     `json:"mine" msgpack:"mine"`
+	Fee         uint64                   `json:"fee" msgpack:"fee"`
 	FeePerByte  uint64                   `json:"feePerByte" msgpack:"feePerByte"`
 	ChainHeight uint64                   `json:"chainHeight" msgpack:"chainHeight"`
 }
@@ -36,6 +36,7 @@ type Mempool struct {
 	removeTransactionsCn      chan *MempoolWorkerRemoveTxs
 	insertTransactionsCn      chan *MempoolWorkerInsertTxs
 	Txs                       *MempoolTxs
+	Fees                      *MempoolFeeEstimator
 	OnBroadcastNewTransaction func([]*transaction.Transaction, bool, bool, advanced_connection_types.UUID, context.Context) []error
 }
 
@@ -45,7 +46,7 @@ func (mempool *Mempool) ContinueProcessing(continueProcessingType ContinueProces
 
 func (mempool *Mempool) RemoveInsertedTxsFromBlockchain(txs []string) bool {
 	answerCn := make(chan bool)
-	mempool.removeTransactionsCn <- &MempoolWorkerRemoveTxs{txs, answerCn}
+	mempool.removeTransactionsCn <- &MempoolWorkerRemoveTxs{txs, true, answerCn}
 	return <-answerCn
 }
 
@@ -104,19 +105,18 @@ func (mempool *Mempool) processTxsToMempool(txs []*transaction.Transaction, heig
 
 		checkFee := true
 
-		minerFee, err := tx.GetAllFee()
+		fee, err := tx.GetAllFee()
 		if err != nil {
 			errs[i] = err
 			continue
 		}
 
-		computedFeePerByte := minerFee
-		if errs[i] = helpers.SafeUint64Sub(&computedFeePerByte, tx.SpaceExtra*config_fees.FEE_PER_BYTE_EXTRA_SPACE); errs[i] != nil {
+		computedFeePerByte, err := getTxFeePerByte(tx)
+		if err != nil {
+			errs[i] = err
 			continue
 		}
 
-		computedFeePerByte = minerFee / tx.Bloom.Size
-
 		requiredFeePerByte := uint64(0)
 		switch tx.Version {
 		case transaction_type.TX_SIMPLE:
@@ -142,6 +142,7 @@ func (mempool *Mempool) processTxsToMempool(txs []*transaction.Transaction, heig
 		finalTxs[i] = &mempoolTx{
 			Tx:          tx,
 			Added:       time.Now().Unix(),
+			Fee:         fee,
 			FeePerByte:  computedFeePerByte,
 			ChainHeight: height,
 		}
@@ -155,6 +156,12 @@ func (mempool *Mempool) AddTxsToMempool(txs []*transaction.Transaction, height u
 
 	finalTxs, errs := mempool.processTxsToMempool(txs, height, ctx)
 
+	for _, finalTx := range finalTxs {
+		if finalTx != nil {
+			finalTx.Mine = justCreated
+		}
+	}
+
 	//making sure that the transaction is not inserted twice
 	if runtime.GOARCH != "wasm" {
 		for i, finalTx := range finalTxs {
@@ -246,6 +253,7 @@ func CreateMempool() (*Mempool, error) {
 		make(chan *MempoolWorkerRemoveTxs),
 		make(chan *MempoolWorkerInsertTxs),
 		createMempoolTxs(),
+		&MempoolFeeEstimator{},
 		nil,
 	}
 
@@ -16,11 +16,8 @@ func (mempool *Mempool) initCLI() {
 	cliShowTxs := func(cmd string, ctx context.Context) (err error) {
 
 		transactions := mempool.Txs.GetTxsFromMap()
-		if len(transactions) == 0 {
-			return
-		}
 
-		gui.GUI.OutputWrite("Mempool Transactions:")
+		gui.GUI.OutputWrite(fmt.Sprintf("Mempool Transactions: %d, Size: %d B", len(transactions), mempool.Txs.GetSize()))
 		for _, out := range transactions {
 			switch out.Tx.Version {
 			case transaction_type.TX_SIMPLE:
@@ -33,6 +30,14 @@ func (mempool *Mempool) initCLI() {
 			}
 		}
 
+		evicted := mempool.Txs.GetEvictedTxs()
+		if len(evicted) > 0 {
+			gui.GUI.OutputWrite("Mempool Evicted Transactions:")
+			for _, out := range evicted {
+				gui.GUI.OutputWrite(fmt.Sprintf("%12s %15s %s", time.Unix(out.Time, 0).UTC().Format(time.RFC822), base64.StdEncoding.EncodeToString(out.Hash[0:15]), out.Reason))
+			}
+		}
+
 		return
 	}
 
@@ -139,4 +139,33 @@ func sortTxs(txList []*mempoolTx) {
 
 		return txList[i].FeePerByte < txList[j].FeePerByte
 	})
+
+	sortSendersTxsByNonce(txList)
+}
+
+// sortSendersTxsByNonce keeps the places of the txs of the same sender, but orders them by nonce, so a tx is never processed before the lower nonces
+func sortSendersTxsByNonce(txList []*mempoolTx) {
+
+	sendersIndexes := make(map[string][]int)
+	for i, tx := range txList {
+		if sender := getTxSender(tx); sender != "" {
+			sendersIndexes[sender] = append(sendersIndexes[sender], i)
+		}
+	}
+
+	for _, indexes := range sendersIndexes {
+		if len(indexes) < 2 {
+			continue
+		}
+		senderTxs := make([]*mempoolTx, len(indexes))
+		for i, index := range indexes {
+			senderTxs[i] = txList[index]
+		}
+		sort.Slice(senderTxs, func(i, j int) bool {
+			return senderTxs[i].Tx.TransactionBaseInterface.(*transaction_simple.TransactionSimple).Nonce < senderTxs[j].Tx.TransactionBaseInterface.(*transaction_simple.TransactionSimple).Nonce
+		})
+		for i, index := range indexes {
+			txList[index] = senderTxs[i]
+		}
+	}
 }
@@ -0,0 +1,156 @@
+package mempool
+
+import (
+	"errors"
+	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/blockchain/transactions/transaction/transaction_type"
+	"pandora-pay/config"
+	"pandora-pay/config/config_fees"
+	"pandora-pay/helpers"
+	"pandora-pay/helpers/generics"
+	"sort"
+	"sync"
+)
+
+type FeeEstimate struct {
+	Low    uint64 `json:"low" msgpack:"low"`
+	Medium uint64 `json:"medium" msgpack:"medium"`
+	High   uint64 `json:"high" msgpack:"high"`
+}
+
+type FeeEstimates struct {
+	Simple            *FeeEstimate `json:"simple" msgpack:"simple"`
+	Zether            *FeeEstimate `json:"zether" msgpack:"zether"`
+	PerByteExtraSpace uint64       `json:"perByteExtraSpace" msgpack:"perByteExtraSpace"`
+}
+
+type mempoolFeeEstimatorBlock struct {
+	height  uint64
+	samples map[transaction_type.TransactionVersion][]uint64
+}
+
+// MempoolFeeEstimator keeps the fee per byte of the txs included in the last blocks
+type MempoolFeeEstimator struct {
+	blocks []*mempoolFeeEstimatorBlock
+	lock   sync.RWMutex
+}
+
+func getTxFeePerByte(tx *transaction.Transaction) (uint64, error) {
+
+	minerFee, err := tx.GetAllFee()
+	if err != nil {
+		return 0, err
+	}
+
+	feePerByte := minerFee
+	if err = helpers.SafeUint64Sub(&feePerByte, tx.SpaceExtra*config_fees.FEE_PER_BYTE_EXTRA_SPACE); err != nil {
+		return 0, err
+	}
+
+	if tx.Bloom == nil || tx.Bloom.Size == 0 {
+		return 0, errors.New("Transaction is not bloomed")
+	}
+
+	return feePerByte / tx.Bloom.Size, nil
+}
+
+// removeBlocksNow removes the samples of the blocks starting with height
+func (estimator *MempoolFeeEstimator) removeBlocksNow(height uint64) {
+	for len(estimator.blocks) > 0 && estimator.blocks[len(estimator.blocks)-1].height >= height {
+		estimator.blocks = estimator.blocks[:len(estimator.blocks)-1]
+	}
+}
+
+// RemoveBlocks removes the samples of the blocks removed by a reorg
+func (estimator *MempoolFeeEstimator) RemoveBlocks(height uint64) {
+	estimator.lock.Lock()
+	defer estimator.lock.Unlock()
+	estimator.removeBlocksNow(height)
+}
+
+// AddBlockTxs records the fees of the txs included in a new block. Txs without fee like the staking rewards are ignored
+// The samples of the previous blocks with the same or a greater height were replaced by a reorg and are removed
+func (estimator *MempoolFeeEstimator) AddBlockTxs(height uint64, txs []*transaction.Transaction) {
+
+	samples := make(map[transaction_type.TransactionVersion][]uint64)
+	for _, tx := range txs {
+		if feePerByte, err := getTxFeePerByte(tx); err == nil && feePerByte > 0 {
+			samples[tx.Version] = append(samples[tx.Version], feePerByte)
+		}
+	}
+
+	estimator.lock.Lock()
+	defer estimator.lock.Unlock()
+
+	estimator.removeBlocksNow(height)
+	estimator.blocks = append(estimator.blocks, &mempoolFeeEstimatorBlock{height, samples})
+	if uint64(len(estimator.blocks)) > config.MEMPOOL_FEE_ESTIMATE_BLOCKS {
+		estimator.blocks = estimator.blocks[uint64(len(estimator.blocks))-config.MEMPOOL_FEE_ESTIMATE_BLOCKS:]
+	}
+}
+
+func percentile(sorted []uint64, percent int) uint64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	return sorted[(len(sorted)-1)*percent/100]
+}
+
+func (estimator *MempoolFeeEstimator) estimate(version transaction_type.TransactionVersion, minimum, backlog uint64) *FeeEstimate {
+
+	estimator.lock.RLock()
+	samples := make([]uint64, 0)
+	for _, block := range estimator.blocks {
+		samples = append(samples, block.samples[version]...)
+	}
+	estimator.lock.RUnlock()
+
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i] < samples[j]
+	})
+
+	estimate := &FeeEstimate{
+		percentile(samples, 25),
+		percentile(samples, 50),
+		percentile(samples, 90),
+	}
+
+	//the pending txs don't fit in the next block, so the fee must outbid the backlog
+	if backlog > 0 {
+		estimate.Medium = generics.Max(estimate.Medium, backlog)
+		estimate.High = generics.Max(estimate.High, backlog+backlog/10+1)
+	}
+
+	estimate.Low = generics.Max(estimate.Low, minimum)
+	estimate.Medium = generics.Max(estimate.Medium, estimate.Low)
+	estimate.High = generics.Max(estimate.High, estimate.Medium)
+
+	return estimate
+}
+
+// GetFeeEstimates returns the estimated fee per byte for the simple and zether txs
+func (mempool *Mempool) GetFeeEstimates() *FeeEstimates {
+
+	//the fee per byte of the last tx that still fits in the next block
+	backlog := uint64(0)
+
+	txs := mempool.Txs.GetTxsList()
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].FeePerByte > txs[j].FeePerByte
+	})
+
+	size := uint64(0)
+	for _, tx := range txs {
+		size += tx.Tx.Bloom.Size
+		if size >= config.BLOCK_MAX_SIZE {
+			backlog = tx.FeePerByte
+			break
+		}
+	}
+
+	return &FeeEstimates{
+		mempool.Fees.estimate(transaction_type.TX_SIMPLE, config_fees.FEE_PER_BYTE, backlog),
+		mempool.Fees.estimate(transaction_type.TX_ZETHER, config_fees.FEE_PER_BYTE_ZETHER, backlog),
+		config_fees.FEE_PER_BYTE_EXTRA_SPACE,
+	}
+}
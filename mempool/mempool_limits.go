@@ -0,0 +1,111 @@
+package mempool
+
+import (
+	"errors"
+	"pandora-pay/blockchain/transactions/transaction/transaction_simple"
+	"pandora-pay/blockchain/transactions/transaction/transaction_type"
+	"pandora-pay/config"
+	"sort"
+	"sync"
+	"time"
+)
+
+const mempoolEvictedHistory = 1000
+
+type MempoolEvictedTx struct {
+	Hash   []byte `json:"hash" msgpack:"hash"`
+	Reason string `json:"reason" msgpack:"reason"`
+	Time   int64  `json:"time" msgpack:"time"`
+}
+
+type mempoolEvictedTxs struct {
+	list []*MempoolEvictedTx
+	sync.RWMutex
+}
+
+func (self *MempoolTxs) addEvictedTxs(txs []*mempoolTx, reason string) {
+
+	now := time.Now().Unix()
+
+	self.evicted.Lock()
+	defer self.evicted.Unlock()
+
+	for _, tx := range txs {
+		self.evicted.list = append(self.evicted.list, &MempoolEvictedTx{tx.Tx.Bloom.Hash, reason, now})
+	}
+	if len(self.evicted.list) > mempoolEvictedHistory {
+		self.evicted.list = self.evicted.list[len(self.evicted.list)-mempoolEvictedHistory:]
+	}
+}
+
+// GetEvictedTxs returns the last evicted txs, the most recent ones are the last
+func (self *MempoolTxs) GetEvictedTxs() []*MempoolEvictedTx {
+	self.evicted.RLock()
+	defer self.evicted.RUnlock()
+
+	out := make([]*MempoolEvictedTx, len(self.evicted.list))
+	copy(out, self.evicted.list)
+	return out
+}
+
+// getTxSender returns the sender public key of the simple txs. Zether senders are hidden in the ring, so they can't be counted
+func getTxSender(tx *mempoolTx) string {
+	if tx.Tx.Version == transaction_type.TX_SIMPLE {
+		base := tx.Tx.TransactionBaseInterface.(*transaction_simple.TransactionSimple)
+		if base.HasVin() {
+			return string(base.Vin.PublicKey)
+		}
+	}
+	return ""
+}
+
+// getTxsToEvict returns the txs with the lowest fee per byte that need to be evicted to make room for the new tx
+func getTxsToEvict(list []*mempoolTx, size uint64, tx, replaced *mempoolTx) ([]*mempoolTx, error) {
+
+	size += tx.Tx.Bloom.Size
+	if replaced != nil {
+		size -= replaced.Tx.Bloom.Size
+	}
+	if size <= config.MEMPOOL_MAX_SIZE {
+		return nil, nil
+	}
+
+	sorted := make([]*mempoolTx, len(list))
+	copy(sorted, list)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].FeePerByte < sorted[j].FeePerByte
+	})
+
+	evicted := make([]*mempoolTx, 0)
+	for _, it := range sorted {
+		if size <= config.MEMPOOL_MAX_SIZE || it.FeePerByte >= tx.FeePerByte {
+			break
+		}
+		if it.Mine || it == replaced {
+			continue
+		}
+		evicted = append(evicted, it)
+		size -= it.Tx.Bloom.Size
+	}
+
+	if size > config.MEMPOOL_MAX_SIZE {
+		return nil, errors.New("Mempool is full and the fee is too low")
+	}
+
+	return evicted, nil
+}
+
+func getExpiredTxs(list []*mempoolTx, height uint64) []*mempoolTx {
+
+	if config.MEMPOOL_MAX_AGE_BLOCKS == 0 {
+		return nil
+	}
+
+	expired := make([]*mempoolTx, 0)
+	for _, tx := range list {
+		if tx.ChainHeight+config.MEMPOOL_MAX_AGE_BLOCKS < height {
+			expired = append(expired, tx)
+		}
+	}
+	return expired
+}
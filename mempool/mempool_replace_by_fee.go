@@ -0,0 +1,29 @@
+package mempool
+
+import (
+	"errors"
+	"pandora-pay/blockchain/transactions/transaction/transaction_simple"
+	"pandora-pay/config"
+	"strconv"
+)
+
+// getTxSlot returns the sender and nonce of the simple txs. Two txs with the same slot are in conflict and only one of them can be included
+func getTxSlot(tx *mempoolTx) string {
+	sender := getTxSender(tx)
+	if sender == "" {
+		return ""
+	}
+	base := tx.Tx.TransactionBaseInterface.(*transaction_simple.TransactionSimple)
+	return sender + ":" + strconv.FormatUint(base.Nonce, 10)
+}
+
+// checkReplacement verifies that the new tx pays more than the pending tx of the same slot that will be replaced
+func checkReplacement(tx, replaced *mempoolTx) error {
+	if tx.Fee <= replaced.Fee {
+		return errors.New("Replacement tx requires a higher fee")
+	}
+	if tx.FeePerByte <= replaced.FeePerByte || tx.FeePerByte*100 < replaced.FeePerByte*(100+config.MEMPOOL_REPLACE_BY_FEE_MIN_INCREASE) {
+		return errors.New("Replacement tx requires a fee per byte increased by at least " + strconv.FormatUint(config.MEMPOOL_REPLACE_BY_FEE_MIN_INCREASE, 10) + "%")
+	}
+	return nil
+}
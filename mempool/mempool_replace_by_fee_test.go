@@ -0,0 +1,133 @@
+package mempool
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pandora-pay/blockchain/blockchain_types"
+	"pandora-pay/blockchain/data_storage"
+	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/blockchain/transactions/transaction/transaction_simple"
+	"pandora-pay/blockchain/transactions/transaction/transaction_simple/transaction_simple_parts"
+	"pandora-pay/blockchain/transactions/transaction/transaction_type"
+	"pandora-pay/cryptography"
+	"pandora-pay/helpers"
+	"pandora-pay/helpers/generics"
+	"pandora-pay/helpers/multicast"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+	"pandora-pay/store/store_db/store_db_memory"
+	"testing"
+)
+
+func createTestMempoolTx(publicKey []byte, nonce, fee, size uint64) *mempoolTx {
+	hash := cryptography.RandomHash()
+	return &mempoolTx{
+		Tx: &transaction.Transaction{
+			TransactionBaseInterface: &transaction_simple.TransactionSimple{
+				TxScript: transaction_simple.SCRIPT_UPDATE_ASSET_FEE_LIQUIDITY,
+				Nonce:    nonce,
+				Fee:      fee,
+				Vin:      &transaction_simple_parts.TransactionSimpleInput{PublicKey: publicKey},
+			},
+			Version: transaction_type.TX_SIMPLE,
+			Bloom:   &transaction.TransactionBloom{Size: size, Hash: hash, HashStr: string(hash)},
+		},
+		Fee:        fee,
+		FeePerByte: fee / size,
+	}
+}
+
+func TestMempool_ReplaceByFee(t *testing.T) {
+
+	db, err := store_db_memory.CreateStoreDBMemory("test")
+	assert.NoError(t, err)
+	store.StoreBlockchain = &store.Store{Name: "test", Opened: true, DB: db}
+
+	publicKey := helpers.RandomBytes(cryptography.PublicKeySize)
+
+	err = db.Update(func(writer store_db_interface.StoreDBTransactionInterface) (err error) {
+		dataStorage := data_storage.NewDataStorage(writer)
+		plainAcc, err := dataStorage.CreatePlainAccount(publicKey, false)
+		if err != nil {
+			return
+		}
+		plainAcc.Unclaimed = 10000
+		if err = dataStorage.PlainAccs.Update(string(publicKey), plainAcc); err != nil {
+			return
+		}
+		return dataStorage.CommitChanges()
+	})
+	assert.NoError(t, err)
+
+	txs := &MempoolTxs{
+		evicted:                   &mempoolEvictedTxs{list: []*MempoolEvictedTx{}},
+		txsMap:                    &generics.Map[string, *mempoolTx]{},
+		accountsMapTxs:            &generics.Map[string, *MempoolAccountTxs]{},
+		shortIdsMap:               &generics.Map[string, []*mempoolTx]{},
+		UpdateMempoolTransactions: multicast.NewMulticastChannel[*blockchain_types.MempoolTransactionUpdate](),
+		store:                     &mempoolTxsStore{},
+	}
+
+	newWorkCn := make(chan *mempoolWork)
+	addTransactionCn := make(chan *MempoolWorkerAddTx)
+
+	worker := new(mempoolWorker)
+	go worker.processing(newWorkCn, make(chan struct{}), make(chan ContinueProcessingType), addTransactionCn, make(chan *MempoolWorkerInsertTxs), make(chan *MempoolWorkerRemoveTxs), txs)
+
+	result := &MempoolResult{txs: &generics.Value[[]*mempoolTx]{}, chainHash: cryptography.RandomHash(), chainHeight: 1}
+	result.txs.Store([]*mempoolTx{})
+	newWorkCn <- &mempoolWork{result.chainHash, result.chainHeight, result}
+
+	add := func(tx *mempoolTx) error {
+		answerCn := make(chan error)
+		addTransactionCn <- &MempoolWorkerAddTx{tx, answerCn}
+		return <-answerCn
+	}
+
+	first := createTestMempoolTx(publicKey, 0, 1000, 100)
+	assert.NoError(t, add(first))
+	assert.True(t, txs.Exists(first.Tx.Bloom.HashStr))
+
+	//replaced by a higher fee
+	second := createTestMempoolTx(publicKey, 0, 2000, 100)
+	assert.NoError(t, add(second))
+	assert.False(t, txs.Exists(first.Tx.Bloom.HashStr))
+	assert.True(t, txs.Exists(second.Tx.Bloom.HashStr))
+
+	//rejected because the fee per byte is not increased enough
+	lowFeePerByte := createTestMempoolTx(publicKey, 0, 2100, 100)
+	assert.Error(t, add(lowFeePerByte))
+
+	//rejected because the absolute fee is lower even if the fee per byte is higher
+	lowFee := createTestMempoolTx(publicKey, 0, 1900, 50)
+	assert.Error(t, add(lowFee))
+
+	assert.False(t, txs.Exists(lowFeePerByte.Tx.Bloom.HashStr))
+	assert.False(t, txs.Exists(lowFee.Tx.Bloom.HashStr))
+	assert.True(t, txs.Exists(second.Tx.Bloom.HashStr))
+
+	//the replacement can't pay the fee, so the replaced tx is restored
+	failing := createTestMempoolTx(publicKey, 0, 20000, 100)
+	assert.Error(t, add(failing))
+	assert.False(t, txs.Exists(failing.Tx.Bloom.HashStr))
+	assert.True(t, txs.Exists(second.Tx.Bloom.HashStr))
+
+	//the restored tx can still be replaced
+	third := createTestMempoolTx(publicKey, 0, 4000, 100)
+	assert.NoError(t, add(third))
+	assert.False(t, txs.Exists(second.Tx.Bloom.HashStr))
+	assert.True(t, txs.Exists(third.Tx.Bloom.HashStr))
+
+	//different nonce doesn't replace
+	next := createTestMempoolTx(publicKey, 1, 1000, 100)
+	assert.NoError(t, add(next))
+	assert.True(t, txs.Exists(third.Tx.Bloom.HashStr))
+	assert.True(t, txs.Exists(next.Tx.Bloom.HashStr))
+
+	//replacing a higher nonce while the lower nonce is pending keeps the nonce order, even if the lower nonce pays a higher fee per byte
+	nextReplacement := createTestMempoolTx(publicKey, 1, 2000, 100)
+	assert.NoError(t, add(nextReplacement))
+	assert.False(t, txs.Exists(next.Tx.Bloom.HashStr))
+	assert.True(t, txs.Exists(third.Tx.Bloom.HashStr))
+	assert.True(t, txs.Exists(nextReplacement.Tx.Bloom.HashStr))
+	assert.Equal(t, []*mempoolTx{third, nextReplacement}, result.txs.Load())
+}
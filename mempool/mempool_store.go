@@ -0,0 +1,217 @@
+package mempool
+
+import (
+	"context"
+	"github.com/vmihailenco/msgpack/v5"
+	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/config"
+	"pandora-pay/gui"
+	"pandora-pay/helpers/advanced_buffers"
+	"pandora-pay/helpers/recovery"
+	"pandora-pay/network/websocks/connection/advanced_connection_types"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// mempoolTxsStore batches the writes of the mempool txs. Only the full nodes restore the mempool, so only they persist it
+type mempoolTxsStore struct {
+	enabled bool
+	pending map[string][]byte //nil to delete
+	lock    sync.Mutex
+	closed  chan struct{}
+	done    chan struct{}
+}
+
+type mempoolTxStored struct {
+	Tx          []byte `msgpack:"tx"`
+	Added       int64  `msgpack:"added"`
+	Mine        bool   `msgpack:"mine"`
+	ChainHeight uint64 `msgpack:"chainHeight"`
+}
+
+func (self *MempoolTxs) saveTx(tx *mempoolTx) {
+
+	if !self.store.enabled {
+		return
+	}
+
+	data, err := msgpack.Marshal(&mempoolTxStored{tx.Tx.Bloom.Serialized, tx.Added, tx.Mine, tx.ChainHeight})
+	if err != nil {
+		gui.GUI.Error("Error storing mempool tx", err)
+		return
+	}
+
+	self.store.lock.Lock()
+	self.store.pending[tx.Tx.Bloom.HashStr] = data
+	self.store.lock.Unlock()
+}
+
+func (self *MempoolTxs) removeSavedTxs(hashesStr []string) {
+
+	if !self.store.enabled {
+		return
+	}
+
+	self.store.lock.Lock()
+	for _, hashStr := range hashesStr {
+		self.store.pending[hashStr] = nil
+	}
+	self.store.lock.Unlock()
+}
+
+// flushSavedTxs writes all the pending changes in a single transaction
+func (self *MempoolTxs) flushSavedTxs() {
+
+	self.store.lock.Lock()
+	pending := self.store.pending
+	self.store.pending = make(map[string][]byte)
+	self.store.lock.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	if err := store.StoreMempool.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) error {
+		for hashStr, data := range pending {
+			if data == nil {
+				writer.Delete("mempoolTx:" + hashStr)
+			} else {
+				writer.Put("mempoolTx:"+hashStr, data)
+			}
+		}
+		return nil
+	}); err != nil {
+		gui.GUI.Error("Error storing mempool txs", err)
+	}
+}
+
+func (self *MempoolTxs) initStore() {
+
+	self.store = &mempoolTxsStore{
+		enabled: runtime.GOARCH != "wasm" && config.NODE_CONSENSUS == config.NODE_CONSENSUS_TYPE_FULL,
+		pending: make(map[string][]byte),
+		closed:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	if self.store.enabled {
+		recovery.SafeGo(func() {
+
+			defer close(self.store.done)
+
+			ticker := time.NewTicker(time.Second)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					self.flushSavedTxs()
+				case <-self.store.closed:
+					return
+				}
+			}
+		})
+	}
+}
+
+// Close stops the flushing and writes the pending changes before the store is closed
+func (mempool *Mempool) Close() {
+	if mempool.Txs.store.enabled {
+		close(mempool.Txs.store.closed)
+		<-mempool.Txs.store.done
+		mempool.Txs.flushSavedTxs()
+	}
+}
+
+// LoadSavedTxs revalidates the transactions stored before the restart and rebroadcasts the ones created by the wallet
+func (mempool *Mempool) LoadSavedTxs(height uint64) (err error) {
+
+	if !mempool.Txs.store.enabled {
+		return
+	}
+
+	stored := make(map[string]*mempoolTxStored)
+
+	if err = store.StoreMempool.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+		reader.Range("mempoolTx:", func(key string, value []byte) bool {
+			data := &mempoolTxStored{}
+			if err = msgpack.Unmarshal(value, data); err != nil {
+				return false
+			}
+			stored[key[len("mempoolTx:"):]] = data
+			return true
+		})
+		return
+	}); err != nil {
+		return
+	}
+
+	if len(stored) == 0 {
+		return
+	}
+
+	list := make([]*mempoolTx, 0, len(stored))
+	invalid := make([]string, 0)
+
+	for hashStr, data := range stored {
+		tx := &transaction.Transaction{}
+		if err = tx.Deserialize(advanced_buffers.NewBufferReader(data.Tx)); err != nil || tx.Bloom.HashStr != hashStr {
+			invalid = append(invalid, hashStr)
+			continue
+		}
+		list = append(list, &mempoolTx{Tx: tx, Added: data.Added})
+	}
+
+	//the txs are restored in the order they were added, but the txs of the same sender by nonce, otherwise the higher nonces would be rejected
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Added < list[j].Added
+	})
+	sortSendersTxsByNonce(list)
+
+	mine := make([]*transaction.Transaction, 0)
+	for _, it := range list {
+
+		tx := it.Tx
+
+		//processed one by one because an invalid tx stops the processing of the next ones
+		finalTxs, _ := mempool.processTxsToMempool([]*transaction.Transaction{tx}, height, context.Background())
+
+		finalTx := finalTxs[0]
+		if finalTx == nil {
+			if !mempool.Txs.Exists(tx.Bloom.HashStr) {
+				invalid = append(invalid, tx.Bloom.HashStr)
+			}
+			continue
+		}
+
+		data := stored[finalTx.Tx.Bloom.HashStr]
+		finalTx.Added = data.Added
+		finalTx.Mine = data.Mine
+		finalTx.ChainHeight = data.ChainHeight
+
+		answerCn := make(chan error)
+		mempool.addTransactionCn <- &MempoolWorkerAddTx{finalTx, answerCn}
+		if err = <-answerCn; err != nil {
+			invalid = append(invalid, finalTx.Tx.Bloom.HashStr)
+			continue
+		}
+
+		if finalTx.Mine {
+			mine = append(mine, finalTx.Tx)
+		}
+	}
+
+	mempool.Txs.removeSavedTxs(invalid)
+
+	gui.GUI.Info("Mempool restored", len(stored)-len(invalid), "txs")
+
+	if len(mine) > 0 && mempool.OnBroadcastNewTransaction != nil {
+		mempool.OnBroadcastNewTransaction(mine, true, false, advanced_connection_types.UUID_ALL, context.Background())
+	}
+
+	return nil
+}
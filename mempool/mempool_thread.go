@@ -26,8 +26,9 @@ type MempoolWorkerAddTx struct {
 }
 
 type MempoolWorkerRemoveTxs struct {
-	Txs    []string
-	Result chan<- bool
+	Txs                  []string
+	IncludedInBlockchain bool
+	Result               chan<- bool
 }
 
 type MempoolWorkerInsertTxs struct {
@@ -51,44 +52,58 @@ func (worker *mempoolWorker) processing(
 
 	txsList := []*mempoolTx{}
 	txsMap := make(map[string]*mempoolTx)
+	sendersCount := make(map[string]uint64)
+	slotsMap := make(map[string]*mempoolTx) //sender and nonce
 	listIndex := 0
 
 	includedTotalSize := uint64(0)
 	includedTxs := []*mempoolTx{}
 
-	resetNow := func(newWork *mempoolWork) {
+	expiredHeight := uint64(0)
 
-		if newWork.chainHash != nil {
-			dataStorage = nil
-			work = newWork
-			includedTotalSize = uint64(0)
-			includedTxs = []*mempoolTx{}
-			listIndex = 0
-			if len(txsList) > 1 {
-				sortTxs(txsList)
+	addTxMap := func(tx *mempoolTx) {
+		txsMap[tx.Tx.Bloom.HashStr] = tx
+		if sender := getTxSender(tx); sender != "" {
+			sendersCount[sender] += 1
+		}
+		if slot := getTxSlot(tx); slot != "" {
+			slotsMap[slot] = tx
+		}
+	}
+
+	deleteTxMap := func(tx *mempoolTx) {
+		delete(txsMap, tx.Tx.Bloom.HashStr)
+		if sender := getTxSender(tx); sender != "" {
+			if sendersCount[sender] <= 1 {
+				delete(sendersCount, sender)
+			} else {
+				sendersCount[sender] -= 1
 			}
 		}
+		if slot := getTxSlot(tx); slot != "" && slotsMap[slot] == tx {
+			delete(slotsMap, slot)
+		}
 	}
 
 	removeTxNow := func(tx *mempoolTx, txWasInserted bool, includedInBlockchainNotification bool) {
 
-		delete(txsMap, tx.Tx.Bloom.HashStr)
+		deleteTxMap(tx)
 
 		if txWasInserted {
 			txs.deleteTx(tx.Tx.Bloom.HashStr)
-			txs.deleted(tx, txWasInserted, includedInBlockchainNotification)
+			txs.deleted(tx, txWasInserted, includedInBlockchainNotification, nil)
 
 		}
 	}
 
-	removeTxs := func(data *MempoolWorkerRemoveTxs) {
+	removeTxsNow := func(hashes []string, includedInBlockchain bool) bool {
 
 		removedTxsMap := make(map[string]bool)
-		for _, hash := range data.Txs {
+		for _, hash := range hashes {
 			if hash != "" {
 				if tx := txsMap[hash]; tx != nil {
 					removedTxsMap[hash] = true
-					removeTxNow(tx, true, true)
+					removeTxNow(tx, true, includedInBlockchain)
 				}
 			}
 		}
@@ -117,14 +132,166 @@ func (worker *mempoolWorker) processing(
 			txsList = newList
 		}
 
-		data.Result <- len(removedTxsMap) > 0
+		return len(removedTxsMap) > 0
+	}
+
+	evictTxsNow := func(list []*mempoolTx, reason string) {
+
+		if len(list) == 0 {
+			return
+		}
+
+		hashes := make([]string, len(list))
+		for i, tx := range list {
+			hashes[i] = tx.Tx.Bloom.HashStr
+		}
+
+		removeTxsNow(hashes, false)
+		txs.addEvictedTxs(list, reason)
+	}
+
+	//the limit per sender and the mempool size are verified by the worker, because only the worker modifies the mempool
+	checkLimitsNow := func(tx, replaced *mempoolTx) ([]*mempoolTx, error) {
+
+		if sender := getTxSender(tx); replaced == nil && sender != "" && sendersCount[sender] >= config.MEMPOOL_MAX_TXS_PER_ACCOUNT {
+			return nil, errors.New("Mempool limit of txs per account was reached")
+		}
+
+		return getTxsToEvict(txsList, txs.GetSize(), tx, replaced)
+	}
+
+	//the tx is included in the work, but not in the block if the block is full
+	includeTxNow := func(dataStorage *data_storage.DataStorage, includedTotalSize uint64, tx *mempoolTx) (included bool, err error) {
+
+		defer func() {
+			if errReturned := recover(); errReturned != nil {
+				err = errReturned.(error)
+			}
+		}()
+
+		if err = tx.Tx.IncludeTransaction(work.chainHeight, dataStorage); err != nil {
+			dataStorage.Rollback()
+			return
+		}
+
+		if includedTotalSize+tx.Tx.Bloom.Size >= config.BLOCK_MAX_SIZE {
+			dataStorage.Rollback()
+			return
+		}
+
+		if err = dataStorage.CommitChanges(); err != nil {
+			return
+		}
+		return true, nil
+	}
+
+	resetNow := func(newWork *mempoolWork) {
+
+		if newWork.chainHash != nil {
+
+			//the expired txs are evicted once per height
+			if newWork.chainHeight != expiredHeight {
+				expiredHeight = newWork.chainHeight
+				evictTxsNow(getExpiredTxs(txsList, newWork.chainHeight), "Expired")
+			}
+
+			dataStorage = nil
+			work = newWork
+			includedTotalSize = uint64(0)
+			includedTxs = []*mempoolTx{}
+			listIndex = 0
+			if len(txsList) > 1 {
+				sortTxs(txsList)
+			}
+		}
+	}
+
+	//the replaced and the evicted txs could have been already included in the work, so the work is processed again from scratch in the sorted order, with the new tx in its nonce slot
+	//the mempool is changed only if the new tx can be included
+	replaceNow := func(dbTx store_db_interface.StoreDBTransactionInterface, tx, replaced *mempoolTx, evicted []*mempoolTx) (err error) {
+
+		if dbTx.Exists("txHash:" + tx.Tx.Bloom.HashStr) {
+			return errors.New("Tx is already included in blockchain")
+		}
+
+		removed := make(map[*mempoolTx]bool)
+		for _, it := range evicted {
+			removed[it] = true
+		}
+
+		list := make([]*mempoolTx, 0, len(txsList)+1)
+		for _, it := range txsList {
+			if it != replaced && !removed[it] {
+				list = append(list, it)
+			}
+		}
+		list = append(list, tx)
+		sortTxs(list)
+
+		newDataStorage := data_storage.NewDataStorage(dbTx)
+		newIncludedTotalSize := uint64(0)
+		newIncludedTxs := []*mempoolTx{}
+		newListIndex := 0
+
+		var included bool
+		for index, it := range list {
+
+			if it != tx && dbTx.Exists("txHash:"+it.Tx.Bloom.HashStr) {
+				continue
+			}
+
+			if included, err = includeTxNow(newDataStorage, newIncludedTotalSize, it); err != nil {
+				if it == tx {
+					return
+				}
+				err = nil //the other txs will be verified again by the next work
+				continue
+			}
+
+			if included {
+				newIncludedTotalSize += it.Tx.Bloom.Size
+				newIncludedTxs = append(newIncludedTxs, it)
+			}
+
+			if it == tx {
+				newListIndex = index + 1
+				break
+			}
+		}
+
+		evictTxsNow(evicted, "Evicted by a tx with a higher fee")
+		if replaced != nil {
+			deleteTxMap(replaced)
+			txs.deleteTx(replaced.Tx.Bloom.HashStr)
+		}
+
+		dataStorage = newDataStorage
+		includedTotalSize = newIncludedTotalSize
+		includedTxs = newIncludedTxs
+		atomic.StoreUint64(&work.result.totalSize, includedTotalSize)
+		work.result.txs.Store(includedTxs)
+
+		txsList = list
+		listIndex = newListIndex
+		addTxMap(tx)
+		txs.insertTx(tx)
+		txs.inserted(tx)
+		if replaced != nil {
+			txs.deleted(replaced, true, false, tx.Tx.Bloom.Hash)
+		}
+
+		return
+	}
+
+	removeTxs := func(data *MempoolWorkerRemoveTxs) {
+		data.Result <- removeTxsNow(data.Txs, data.IncludedInBlockchain)
 	}
 
 	insertTxs := func(data *MempoolWorkerInsertTxs) {
 		result := false
 		for _, tx := range data.Txs {
 			if tx != nil && txsMap[tx.Tx.Bloom.HashStr] == nil {
-				txsMap[tx.Tx.Bloom.HashStr] = tx
+				addTxMap(tx)
 				txs.insertTx(tx)
 				txs.inserted(tx)
 				txsList = append(txsList, tx)
@@ -203,6 +370,33 @@ func (worker *mempoolWorker) processing(
 							}
 							continue
 						}
+						var replacedTx *mempoolTx
+						if slot := getTxSlot(newAddTx.Tx); slot != "" && slotsMap[slot] != nil {
+							replacedTx = slotsMap[slot]
+							if errReplace := checkReplacement(newAddTx.Tx, replacedTx); errReplace != nil {
+								if newAddTx.Result != nil {
+									newAddTx.Result <- errReplace
+								}
+								continue
+							}
+						}
+
+						evicted, errLimit := checkLimitsNow(newAddTx.Tx, replacedTx)
+						if errLimit != nil {
+							if newAddTx.Result != nil {
+								newAddTx.Result <- errLimit
+							}
+							continue
+						}
+
+						if replacedTx != nil || len(evicted) > 0 {
+							errReplace := replaceNow(dbTx, newAddTx.Tx, replacedTx, evicted)
+							if newAddTx.Result != nil {
+								newAddTx.Result <- errReplace
+							}
+							continue
+						}
+
 						tx = newAddTx.Tx
 					}
 				} else {
@@ -228,7 +422,7 @@ func (worker *mempoolWorker) processing(
 				}
 
 				var finalErr error
-				var exists bool
+				var exists, included bool
 
 				if exists = dbTx.Exists("txHash:" + string(tx.Tx.Bloom.HashStr)); exists {
 					finalErr = errors.New("Tx is already included in blockchain")
@@ -236,58 +430,32 @@ func (worker *mempoolWorker) processing(
 
 				if finalErr == nil {
 					//was rejected by mempool nonce map
-					finalErr = func() (err error) {
-
-						defer func() {
-							if errReturned := recover(); errReturned != nil {
-								err = errReturned.(error)
-							}
-						}()
-
-						if err = tx.Tx.IncludeTransaction(work.chainHeight, dataStorage); err != nil {
-							dataStorage.Rollback()
-							return
-						} else {
-
-							if includedTotalSize+tx.Tx.Bloom.Size < config.BLOCK_MAX_SIZE {
+					if included, finalErr = includeTxNow(dataStorage, includedTotalSize, tx); included {
 
-								includedTotalSize += tx.Tx.Bloom.Size
-								includedTxs = append(includedTxs, tx)
+						includedTotalSize += tx.Tx.Bloom.Size
+						includedTxs = append(includedTxs, tx)
 
-								atomic.StoreUint64(&work.result.totalSize, includedTotalSize)
-								work.result.txs.Store(includedTxs)
-
-								if err = dataStorage.CommitChanges(); err != nil {
-									return
-								}
-
-							} else {
-								dataStorage.Rollback()
-							}
-
-							if newAddTx != nil {
-								listIndex += 1
-								txsList = append(txsList, newAddTx.Tx)
-								txsMap[tx.Tx.Bloom.HashStr] = newAddTx.Tx
-								txs.insertTx(tx)
-								txs.inserted(tx)
-							}
-
-						}
+						atomic.StoreUint64(&work.result.totalSize, includedTotalSize)
+						work.result.txs.Store(includedTxs)
+					}
 
-						return
-					}()
-
-					if finalErr != nil {
-						if newAddTx == nil {
-							//removing
-							//this is done because it was inserted before
-							txsList = slices.Delete(txsList, listIndex-1, listIndex)
-							listIndex--
-						}
-						removeTxNow(tx, newAddTx == nil, exists)
+					if finalErr == nil && newAddTx != nil {
+						txsList = slices.Insert(txsList, listIndex, newAddTx.Tx)
+						listIndex += 1
+						addTxMap(newAddTx.Tx)
+						txs.insertTx(tx)
+						txs.inserted(tx)
 					}
+				}
 
+				if finalErr != nil {
+					if newAddTx == nil {
+						//removing
+						//this is done because it was inserted before
+						txsList = slices.Delete(txsList, listIndex-1, listIndex)
+						listIndex--
+					}
+					removeTxNow(tx, newAddTx == nil, exists)
 				}
 
 				if newAddTx != nil && newAddTx.Result != nil {
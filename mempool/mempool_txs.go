@@ -10,6 +10,7 @@ import (
 	"pandora-pay/helpers/generics"
 	"pandora-pay/helpers/multicast"
 	"pandora-pay/helpers/recovery"
+	"pandora-pay/network/network_config"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -24,19 +25,52 @@ type MempoolAccountTxs struct {
 
 type MempoolTxs struct {
 	count                     int32
+	size                      uint64
+	evicted                   *mempoolEvictedTxs
 	txsMap                    *generics.Map[string, *mempoolTx]
 	accountsMapTxs            *generics.Map[string, *MempoolAccountTxs]
+	shortIdsMap               *generics.Map[string, []*mempoolTx] //only the worker updates it
 	UpdateMempoolTransactions *multicast.MulticastChannel[*blockchain_types.MempoolTransactionUpdate]
+	store                     *mempoolTxsStore
 }
 
 func (self *MempoolTxs) insertTx(tx *mempoolTx) bool {
 	_, loaded := self.txsMap.LoadOrStore(tx.Tx.Bloom.HashStr, tx)
 	if !loaded {
 		atomic.AddInt32(&self.count, 1)
+		atomic.AddUint64(&self.size, tx.Tx.Bloom.Size)
+		self.insertShortId(tx)
+		self.saveTx(tx)
 	}
 	return !loaded
 }
 
+func getTxShortId(hashStr string) string {
+	return hashStr[:network_config.BLOCK_COMPACT_TX_ID_SIZE]
+}
+
+func (self *MempoolTxs) insertShortId(tx *mempoolTx) {
+	shortId := getTxShortId(tx.Tx.Bloom.HashStr)
+	list, _ := self.shortIdsMap.Load(shortId)
+	self.shortIdsMap.Store(shortId, append(append([]*mempoolTx{}, list...), tx))
+}
+
+func (self *MempoolTxs) deleteShortId(tx *mempoolTx) {
+	shortId := getTxShortId(tx.Tx.Bloom.HashStr)
+	list, _ := self.shortIdsMap.Load(shortId)
+	newList := make([]*mempoolTx, 0, len(list))
+	for _, it := range list {
+		if it != tx {
+			newList = append(newList, it)
+		}
+	}
+	if len(newList) == 0 {
+		self.shortIdsMap.Delete(shortId)
+	} else {
+		self.shortIdsMap.Store(shortId, newList)
+	}
+}
+
 func (self *MempoolTxs) inserted(tx *mempoolTx) {
 	if config.NODE_PROVIDE_EXTENDED_INFO_APP {
 
@@ -65,20 +99,24 @@ func (self *MempoolTxs) inserted(tx *mempoolTx) {
 			tx.Tx,
 			false,
 			keys,
+			nil,
 		})
 
 	}
 }
 
 func (self *MempoolTxs) deleteTx(hashStr string) bool {
-	_, deleted := self.txsMap.LoadAndDelete(hashStr)
+	tx, deleted := self.txsMap.LoadAndDelete(hashStr)
 	if deleted {
 		atomic.AddInt32(&self.count, -1)
+		atomic.AddUint64(&self.size, ^(tx.Tx.Bloom.Size - 1))
+		self.deleteShortId(tx)
+		self.removeSavedTxs([]string{hashStr})
 	}
 	return deleted
 }
 
-func (self *MempoolTxs) deleted(tx *mempoolTx, broadcastNotifications, includedInBlockchainNotification bool) {
+func (self *MempoolTxs) deleted(tx *mempoolTx, broadcastNotifications, includedInBlockchainNotification bool, replacedBy []byte) {
 	if config.NODE_PROVIDE_EXTENDED_INFO_APP {
 
 		keys := tx.Tx.GetAllKeys()
@@ -101,6 +139,7 @@ func (self *MempoolTxs) deleted(tx *mempoolTx, broadcastNotifications, includedI
 				tx.Tx,
 				includedInBlockchainNotification,
 				keys,
+				replacedBy,
 			})
 		}
 
@@ -142,6 +181,10 @@ func (self *MempoolTxs) GetTxsOnlyList() []*transaction.Transaction {
 	return out
 }
 
+func (self *MempoolTxs) GetSize() uint64 {
+	return atomic.LoadUint64(&self.size)
+}
+
 func (self *MempoolTxs) Exists(txId string) bool {
 	_, loaded := self.txsMap.Load(txId)
 	return loaded
@@ -156,6 +199,20 @@ func (self *MempoolTxs) Get(txId string) *mempoolTx {
 	return value
 }
 
+// GetTxsByShortIds returns the txs whose hashes start with the short ids. Ambiguous short ids are returned as nil
+func (self *MempoolTxs) GetTxsByShortIds(shortIds [][]byte) []*transaction.Transaction {
+
+	out := make([]*transaction.Transaction, len(shortIds))
+	for i, shortId := range shortIds {
+		if len(shortId) == network_config.BLOCK_COMPACT_TX_ID_SIZE {
+			if list, _ := self.shortIdsMap.Load(string(shortId)); len(list) == 1 {
+				out[i] = list[0].Tx
+			}
+		}
+	}
+	return out
+}
+
 func (self *MempoolTxs) GetAccountTxs(publicKey []byte) []*mempoolTx {
 
 	if config.NODE_PROVIDE_EXTENDED_INFO_APP {
@@ -183,11 +240,17 @@ func createMempoolTxs() (txs *MempoolTxs) {
 
 	txs = &MempoolTxs{
 		0,
+		0,
+		&mempoolEvictedTxs{list: []*MempoolEvictedTx{}},
 		&generics.Map[string, *mempoolTx]{},
 		&generics.Map[string, *MempoolAccountTxs]{},
+		&generics.Map[string, []*mempoolTx]{},
 		multicast.NewMulticastChannel[*blockchain_types.MempoolTransactionUpdate](),
+		nil,
 	}
 
+	txs.initStore()
+
 	//printing from time to time the mempool
 	if config.DEBUG {
 		recovery.SafeGo(func() {
@@ -7,5 +7,5 @@ import (
 )
 
 func Handshake(conn *connection.AdvancedConnection, values []byte) (interface{}, error) {
-	return &connection.ConnectionHandshake{config.NAME, config.VERSION_STRING, config.NETWORK_SELECTED, config.NODE_CONSENSUS, network_config.NETWORK_WEBSOCKET_ADDRESS_URL_STRING}, nil
+	return &connection.ConnectionHandshake{config.NAME, config.VERSION_STRING, config.NETWORK_SELECTED, config.NODE_CONSENSUS, network_config.NETWORK_WEBSOCKET_ADDRESS_URL_STRING, network_config.WEBSOCKETS_FEATURES}, nil
 }
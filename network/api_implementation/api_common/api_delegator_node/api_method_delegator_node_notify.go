@@ -113,6 +113,7 @@ func (api *DelegatorNode) DelegatorNotify(r *http.Request, args *ApiDelegatorNod
 		},
 		"",
 		"",
+		false,
 	}, true); err != nil {
 		return
 	}
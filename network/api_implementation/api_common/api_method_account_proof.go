@@ -0,0 +1,65 @@
+package api_common
+
+import (
+	"encoding/binary"
+	"net/http"
+	"pandora-pay/blockchain/data_storage/accounts"
+	"pandora-pay/blockchain/data_storage/accounts/account"
+	"pandora-pay/config/config_coins"
+	"pandora-pay/helpers"
+	"pandora-pay/network/api_implementation/api_common/api_types"
+	"pandora-pay/store"
+	"pandora-pay/store/state_tree"
+	"pandora-pay/store/store_db/store_db_interface"
+)
+
+type APIAccountProofRequest struct {
+	api_types.APIAccountBaseRequest
+	Asset helpers.Base64 `json:"asset,omitempty" msgpack:"asset,omitempty"`
+}
+
+// StateHash is committed as PrevStateHash by the block at ChainHeight
+type APIAccountProofReply struct {
+	ChainHeight       uint64                 `json:"chainHeight" msgpack:"chainHeight"`
+	StateHash         helpers.Base64         `json:"stateHash" msgpack:"stateHash"`
+	LeafKey           helpers.Base64         `json:"leafKey" msgpack:"leafKey"`
+	AccountSerialized helpers.Base64         `json:"accountSerialized,omitempty" msgpack:"accountSerialized,omitempty"`
+	Proof             *state_tree.StateProof `json:"proof" msgpack:"proof"`
+}
+
+func (api *APICommon) GetAccountProof(r *http.Request, args *APIAccountProofRequest, reply *APIAccountProofReply) (err error) {
+
+	publicKey, err := args.GetPublicKey(true)
+	if err != nil {
+		return
+	}
+
+	if len(args.Asset) == 0 {
+		args.Asset = config_coins.NATIVE_ASSET_FULL
+	}
+
+	return store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		var accs *accounts.Accounts
+		if accs, err = accounts.NewAccounts(reader, args.Asset); err != nil {
+			return
+		}
+
+		var acc *account.Account
+		if acc, err = accs.Get(string(publicKey)); err != nil {
+			return
+		}
+		if acc != nil {
+			reply.AccountSerialized = helpers.SerializeToBytes(acc)
+		}
+
+		tree := state_tree.NewStateTree(reader)
+
+		reply.ChainHeight, _ = binary.Uvarint(reader.Get("chainHeight"))
+		reply.StateHash = tree.Root()
+		reply.LeafKey = accs.StateLeafKey(string(publicKey))
+		reply.Proof, err = tree.Proof(reply.LeafKey)
+
+		return
+	})
+}
@@ -2,6 +2,7 @@ package api_common
 
 import (
 	"errors"
+	"fmt"
 	"github.com/vmihailenco/msgpack/v5"
 	"net/http"
 	"pandora-pay/blockchain/blocks/block_complete"
@@ -38,6 +39,10 @@ func (api *APICommon) GetBlockComplete(r *http.Request, args *APIBlockCompleteRe
 			return helpers.ReturnErrorIfNot(err, "Block was not found")
 		}
 
+		if reply.BlockComplete.Block.Height < api.ApiStore.chain.LoadPrunedTxsHeight(reader) {
+			return fmt.Errorf("Block %d was pruned", reply.BlockComplete.Block.Height)
+		}
+
 		data := reader.Get("blockTxs" + strconv.FormatUint(reply.BlockComplete.Block.Height, 10))
 		if data == nil {
 			return errors.New("Strange. blockTxs was not found")
@@ -50,7 +55,9 @@ func (api *APICommon) GetBlockComplete(r *http.Request, args *APIBlockCompleteRe
 
 		reply.BlockComplete.Txs = make([]*transaction.Transaction, len(txHashes))
 		for i, txHash := range txHashes {
-			data = reader.Get("tx:" + string(txHash))
+			if data = reader.Get("tx:" + string(txHash)); data == nil {
+				return errors.New("Tx was not found")
+			}
 			reply.BlockComplete.Txs[i] = &transaction.Transaction{}
 			if err = reply.BlockComplete.Txs[i].Deserialize(advanced_buffers.NewBufferReader(data)); err != nil {
 				return
@@ -5,18 +5,22 @@ import (
 	"pandora-pay/config"
 	"pandora-pay/helpers"
 	"pandora-pay/helpers/generics"
+	"pandora-pay/mempool"
 )
 
 type APIMempoolRequest struct {
-	ChainHash helpers.Base64 `json:"chainHash,omitempty" msgpack:"chainHash,omitempty"`
-	Page      int            `json:"page,omitempty" msgpack:"page,omitempty"`
-	Count     int            `json:"count,omitempty" msgpack:"count,omitempty"`
+	ChainHash   helpers.Base64 `json:"chainHash,omitempty" msgpack:"chainHash,omitempty"`
+	Page        int            `json:"page,omitempty" msgpack:"page,omitempty"`
+	Count       int            `json:"count,omitempty" msgpack:"count,omitempty"`
+	ShowEvicted bool           `json:"showEvicted,omitempty" msgpack:"showEvicted,omitempty"`
 }
 
 type APIMempoolReply struct {
-	ChainHash []byte   `json:"chainHash" msgpack:"chainHash"`
-	Count     int      `json:"count" msgpack:"count"`
-	Hashes    [][]byte `json:"hashes" msgpack:"hashes"`
+	ChainHash []byte                      `json:"chainHash" msgpack:"chainHash"`
+	Count     int                         `json:"count" msgpack:"count"`
+	Hashes    [][]byte                    `json:"hashes" msgpack:"hashes"`
+	Size      uint64                      `json:"size" msgpack:"size"`
+	Evicted   []*mempool.MempoolEvictedTx `json:"evicted,omitempty" msgpack:"evicted,omitempty"`
 }
 
 func (api *APICommon) GetMempool(r *http.Request, args *APIMempoolRequest, reply *APIMempoolReply) error {
@@ -42,5 +46,10 @@ func (api *APICommon) GetMempool(r *http.Request, args *APIMempoolRequest, reply
 		reply.Hashes[i] = transactions[start+i].Bloom.Hash
 	}
 
+	reply.Size = api.mempool.Txs.GetSize()
+	if args.ShowEvicted {
+		reply.Evicted = api.mempool.Txs.GetEvictedTxs()
+	}
+
 	return nil
 }
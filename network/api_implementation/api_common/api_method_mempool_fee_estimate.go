@@ -0,0 +1,15 @@
+package api_common
+
+import (
+	"net/http"
+	"pandora-pay/mempool"
+)
+
+type APIMempoolFeeEstimateReply struct {
+	mempool.FeeEstimates
+}
+
+func (api *APICommon) GetMempoolFeeEstimate(r *http.Request, args *struct{}, reply *APIMempoolFeeEstimateReply) error {
+	reply.FeeEstimates = *api.mempool.GetFeeEstimates()
+	return nil
+}
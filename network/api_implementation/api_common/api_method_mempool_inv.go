@@ -0,0 +1,52 @@
+package api_common
+
+import (
+	"errors"
+	"github.com/vmihailenco/msgpack/v5"
+	"pandora-pay/cryptography"
+	"pandora-pay/helpers/recovery"
+	"pandora-pay/network/network_config"
+	"pandora-pay/network/websocks/connection"
+)
+
+type APIMempoolInvRequest struct {
+	Hashes [][]byte `json:"hashes" msgpack:"hashes"`
+}
+
+// MempoolInv receives the announced tx hashes and downloads only the txs that are not in the mempool
+func (api *APICommon) MempoolInv(conn *connection.AdvancedConnection, values []byte) (interface{}, error) {
+
+	args := &APIMempoolInvRequest{}
+	if err := msgpack.Unmarshal(values, args); err != nil {
+		return nil, err
+	}
+
+	if len(args.Hashes) > network_config.WEBSOCKETS_TXS_INVENTORY_MAX {
+		conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Too many txs announced")
+		return nil, errors.New("Too many txs announced")
+	}
+
+	missing := make([][]byte, 0)
+	for _, hash := range args.Hashes {
+		if len(hash) != cryptography.HashSize {
+			conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Invalid tx hash")
+			return nil, errors.New("Invalid hash")
+		}
+		if !api.mempool.Txs.Exists(string(hash)) {
+			missing = append(missing, hash)
+		}
+	}
+
+	if len(missing) > 0 {
+		recovery.SafeGo(func() {
+			for _, hash := range missing {
+				if conn.IsClosed.IsSet() {
+					return
+				}
+				api.mempoolNewTxIdProcess(conn, hash, &APIMempoolNewTxReply{})
+			}
+		})
+	}
+
+	return nil, nil
+}
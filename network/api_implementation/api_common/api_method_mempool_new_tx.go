@@ -2,11 +2,14 @@ package api_common
 
 import (
 	"context"
+	"github.com/vmihailenco/msgpack/v5"
 	"net/http"
 	"pandora-pay/blockchain/transactions/transaction"
 	"pandora-pay/cryptography"
 	"pandora-pay/helpers"
 	"pandora-pay/helpers/advanced_buffers"
+	"pandora-pay/network/network_config"
+	"pandora-pay/network/websocks/connection"
 	"pandora-pay/network/websocks/connection/advanced_connection_types"
 	"pandora-pay/txs_validator"
 )
@@ -19,7 +22,7 @@ type APIMempoolNewTxReply struct {
 	Result bool `json:"result" msgpack:"result"`
 }
 
-func (api *APICommon) mempoolNewTx(args *APIMempoolNewTxRequest, reply *APIMempoolNewTxReply, exceptSocketUUID advanced_connection_types.UUID) (err error) {
+func (api *APICommon) mempoolNewTx(args *APIMempoolNewTxRequest, reply *APIMempoolNewTxReply, conn *connection.AdvancedConnection) (err error) {
 
 	hash := cryptography.SHA3(args.Tx)
 
@@ -50,14 +53,21 @@ func (api *APICommon) mempoolNewTx(args *APIMempoolNewTxRequest, reply *APIMempo
 	}()
 
 	tx := &transaction.Transaction{}
-	if err = tx.Deserialize(advanced_buffers.NewBufferReader(args.Tx)); err != nil {
-		return err
+	if err = tx.Deserialize(advanced_buffers.NewBufferReader(args.Tx)); err == nil {
+		err = txs_validator.TxsValidator.ValidateTx(tx)
 	}
-
-	if err = txs_validator.TxsValidator.ValidateTx(tx); err != nil {
+	if err != nil {
+		if conn != nil {
+			conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Invalid transaction")
+		}
 		return
 	}
 
+	exceptSocketUUID := advanced_connection_types.UUID_ALL
+	if conn != nil {
+		exceptSocketUUID = conn.UUID
+	}
+
 	if err = api.mempool.AddTxToMempool(tx, api.chain.GetChainData().Height, false, true, false, exceptSocketUUID, context.Background()); err != nil {
 		return
 	}
@@ -67,5 +77,14 @@ func (api *APICommon) mempoolNewTx(args *APIMempoolNewTxRequest, reply *APIMempo
 }
 
 func (api *APICommon) MempoolNewTx(r *http.Request, args *APIMempoolNewTxRequest, reply *APIMempoolNewTxReply) error {
-	return api.mempoolNewTx(args, reply, advanced_connection_types.UUID_ALL)
+	return api.mempoolNewTx(args, reply, nil)
+}
+
+func (api *APICommon) MempoolNewTxWebsockets(conn *connection.AdvancedConnection, values []byte) (interface{}, error) {
+	args := &APIMempoolNewTxRequest{}
+	if err := msgpack.Unmarshal(values, args); err != nil {
+		return nil, err
+	}
+	reply := &APIMempoolNewTxReply{}
+	return reply, api.mempoolNewTx(args, reply, conn)
 }
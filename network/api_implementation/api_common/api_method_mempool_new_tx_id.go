@@ -6,6 +6,7 @@ import (
 	"errors"
 	"pandora-pay/blockchain/transactions/transaction"
 	"pandora-pay/helpers/advanced_buffers"
+	"pandora-pay/network/network_config"
 	"pandora-pay/network/websocks/connection"
 	"pandora-pay/txs_validator"
 )
@@ -54,17 +55,20 @@ func (api *APICommon) mempoolNewTxIdProcess(conn *connection.AdvancedConnection,
 	tx := &transaction.Transaction{}
 	if err = tx.Deserialize(advanced_buffers.NewBufferReader(result.Tx)); err != nil {
 		closeConnection = true
+		conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Invalid transaction")
 		return
 	}
 
 	if err = txs_validator.TxsValidator.ValidateTx(tx); err != nil {
 		closeConnection = true
+		conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Invalid transaction")
 		return
 	}
 
 	if !bytes.Equal(tx.Bloom.Hash, hash) {
 		err = errors.New("Wrong transaction")
 		closeConnection = true
+		conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Wrong transaction")
 		return
 	}
 
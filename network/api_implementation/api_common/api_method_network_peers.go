@@ -0,0 +1,115 @@
+package api_common
+
+import (
+	"errors"
+	"net/http"
+	"pandora-pay/network/banned_nodes"
+	"pandora-pay/network/websocks"
+	"pandora-pay/network/websocks/connection/advanced_connection_types"
+	"time"
+)
+
+type APINetworkPeersRequest struct {
+	ShowBanned bool `json:"showBanned,omitempty" msgpack:"showBanned,omitempty"`
+}
+
+type APINetworkBannedNode struct {
+	URL        string `json:"url" msgpack:"url"`
+	Message    string `json:"message" msgpack:"message"`
+	Timestamp  int64  `json:"timestamp" msgpack:"timestamp"`
+	Expiration int64  `json:"expiration" msgpack:"expiration"`
+}
+
+type APINetworkPeersReply struct {
+	Peers  []*websocks.PeerInfo    `json:"peers" msgpack:"peers"`
+	Banned []*APINetworkBannedNode `json:"banned,omitempty" msgpack:"banned,omitempty"`
+}
+
+type APINetworkConnectRequest struct {
+	URL string `json:"url" msgpack:"url"`
+}
+
+type APINetworkConnectReply struct {
+	UUID advanced_connection_types.UUID `json:"uuid" msgpack:"uuid"`
+}
+
+type APINetworkDisconnectRequest struct {
+	UUID advanced_connection_types.UUID `json:"uuid" msgpack:"uuid"`
+}
+
+type APINetworkBanRequest struct {
+	URL      string `json:"url" msgpack:"url"`
+	Reason   string `json:"reason" msgpack:"reason"`
+	Duration uint64 `json:"duration" msgpack:"duration"` //seconds
+}
+
+type APINetworkBanReply struct {
+	Disconnected int `json:"disconnected" msgpack:"disconnected"`
+}
+
+type APINetworkUnbanRequest struct {
+	URL string `json:"url" msgpack:"url"`
+}
+
+type APINetworkStatusReply struct {
+	Status bool `json:"status" msgpack:"status"`
+}
+
+func (api *APICommon) GetNetworkPeers(r *http.Request, args *APINetworkPeersRequest, reply *APINetworkPeersReply, authenticated bool) error {
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	reply.Peers = websocks.Websockets.GetPeers()
+
+	if args.ShowBanned {
+		list := banned_nodes.BannedNodes.GetList()
+		reply.Banned = make([]*APINetworkBannedNode, len(list))
+		for i, bannedNode := range list {
+			reply.Banned[i] = &APINetworkBannedNode{bannedNode.URL.String(), bannedNode.Message, bannedNode.Timestamp.Unix(), bannedNode.Expiration.Unix()}
+		}
+	}
+
+	return nil
+}
+
+func (api *APICommon) NetworkConnect(r *http.Request, args *APINetworkConnectRequest, reply *APINetworkConnectReply, authenticated bool) error {
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	conn, err := websocks.Websockets.ConnectPeer(args.URL)
+	if err != nil {
+		return err
+	}
+
+	reply.UUID = conn.UUID
+	return nil
+}
+
+func (api *APICommon) NetworkDisconnect(r *http.Request, args *APINetworkDisconnectRequest, reply *APINetworkStatusReply, authenticated bool) error {
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	reply.Status = websocks.Websockets.DisconnectPeer(args.UUID)
+	return nil
+}
+
+func (api *APICommon) NetworkBan(r *http.Request, args *APINetworkBanRequest, reply *APINetworkBanReply, authenticated bool) (err error) {
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	reply.Disconnected, err = websocks.Websockets.BanPeer(args.URL, args.Reason, time.Duration(args.Duration)*time.Second)
+	return
+}
+
+func (api *APICommon) NetworkUnban(r *http.Request, args *APINetworkUnbanRequest, reply *APINetworkStatusReply, authenticated bool) error {
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	reply.Status = websocks.Websockets.UnbanPeer(args.URL)
+	return nil
+}
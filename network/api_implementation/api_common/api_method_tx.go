@@ -43,6 +43,9 @@ func (api *APICommon) openLoadTx(args *APITxRequest, reply *APITxReply) error {
 		var data []byte
 
 		if data = reader.Get("tx:" + hashStr); data == nil {
+			if api.ApiStore.chain.IsTxPruned(reader, hashStr) {
+				return errors.New("Tx was pruned")
+			}
 			return errors.New("Tx not found")
 		}
 
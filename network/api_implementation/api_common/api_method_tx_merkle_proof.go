@@ -0,0 +1,86 @@
+package api_common
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/vmihailenco/msgpack/v5"
+	"net/http"
+	"pandora-pay/cryptography/merkle_tree"
+	"pandora-pay/helpers"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+	"strconv"
+)
+
+type APITxMerkleProofRequest struct {
+	Hash helpers.Base64 `json:"hash" msgpack:"hash"`
+}
+
+type APITxMerkleProofReply struct {
+	BlockHeight uint64           `json:"blockHeight" msgpack:"blockHeight"`
+	BlockHash   helpers.Base64   `json:"blockHash" msgpack:"blockHash"`
+	MerkleHash  helpers.Base64   `json:"merkleHash" msgpack:"merkleHash"`
+	Index       uint64           `json:"index" msgpack:"index"`
+	Proof       []helpers.Base64 `json:"proof" msgpack:"proof"`
+}
+
+func (api *APICommon) GetTxMerkleProof(r *http.Request, args *APITxMerkleProofRequest, reply *APITxMerkleProofReply) error {
+	return store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		hashStr := string(args.Hash)
+
+		data := reader.Get("txBlock:" + hashStr)
+		if data == nil {
+			return errors.New("Tx was not included in a block")
+		}
+
+		var n int
+		if reply.BlockHeight, n = binary.Uvarint(data); n <= 0 {
+			return errors.New("Invalid Tx block height")
+		}
+
+		if data = reader.Get("blockTxs" + strconv.FormatUint(reply.BlockHeight, 10)); data == nil {
+			return errors.New("Block was pruned")
+		}
+
+		txHashes := [][]byte{}
+		if err = msgpack.Unmarshal(data, &txHashes); err != nil {
+			return
+		}
+
+		index := -1
+		for i, txHash := range txHashes {
+			if bytes.Equal(txHash, args.Hash) {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return errors.New("Tx was not found in the block")
+		}
+
+		if reply.BlockHash, err = api.ApiStore.chain.LoadBlockHash(reader, reply.BlockHeight); err != nil {
+			return
+		}
+
+		blk, err := api.ApiStore.loadBlock(reader, reply.BlockHash)
+		if err != nil || blk == nil {
+			return helpers.ReturnErrorIfNot(err, "Block was not found")
+		}
+
+		proof, err := merkle_tree.MerkleProof(txHashes, index)
+		if err != nil {
+			return
+		}
+
+		reply.MerkleHash = blk.MerkleHash
+		reply.Index = uint64(index)
+		reply.Proof = make([]helpers.Base64, len(proof))
+		for i := range proof {
+			reply.Proof[i] = proof[i]
+		}
+
+		return
+	})
+}
@@ -0,0 +1,28 @@
+package api_common
+
+import (
+	"errors"
+	"net/http"
+	"pandora-pay/wallet"
+)
+
+type APIWalletAddContactRequest struct {
+	wallet.WalletContact
+}
+
+type APIWalletAddContactReply struct {
+	Status bool `json:"status" msgpack:"status"`
+}
+
+func (api *APICommon) WalletAddContact(r *http.Request, args *APIWalletAddContactRequest, reply *APIWalletAddContactReply, authenticated bool) error {
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	if err := api.wallet.AddContact(&args.WalletContact); err != nil {
+		return err
+	}
+
+	reply.Status = true
+	return nil
+}
@@ -0,0 +1,20 @@
+package api_common
+
+import (
+	"errors"
+	"net/http"
+	"pandora-pay/wallet"
+)
+
+type APIWalletGetContactsReply struct {
+	Contacts []*wallet.WalletContact `json:"contacts" msgpack:"contacts"`
+}
+
+func (api *APICommon) GetWalletContacts(r *http.Request, args *struct{}, reply *APIWalletGetContactsReply, authenticated bool) error {
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	reply.Contacts = api.wallet.GetContacts()
+	return nil
+}
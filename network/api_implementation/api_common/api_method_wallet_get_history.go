@@ -0,0 +1,33 @@
+package api_common
+
+import (
+	"errors"
+	"net/http"
+	"pandora-pay/config"
+	"pandora-pay/network/api_implementation/api_common/api_types"
+	"pandora-pay/wallet"
+)
+
+type APIWalletGetHistoryRequest struct {
+	api_types.APIAccountBaseRequest
+	Start int `json:"start,omitempty" msgpack:"start,omitempty"`
+}
+
+type APIWalletGetHistoryReply struct {
+	History []*wallet.WalletHistoryTx `json:"history" msgpack:"history"`
+}
+
+func (api *APICommon) GetWalletHistory(r *http.Request, args *APIWalletGetHistoryRequest, reply *APIWalletGetHistoryReply, authenticated bool) (err error) {
+
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	publicKey, err := args.GetPublicKey(true)
+	if err != nil {
+		return
+	}
+
+	reply.History, err = api.wallet.GetHistory(publicKey, args.Start, config.API_WALLET_MAX_HISTORY)
+	return
+}
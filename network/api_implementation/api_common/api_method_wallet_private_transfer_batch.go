@@ -0,0 +1,33 @@
+package api_common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/txs_builder"
+)
+
+type APIWalletPrivateTransferBatchRequest struct {
+	Data      *txs_builder.TxBuilderBatchData `json:"data" msgpack:"data"`
+	Propagate bool                            `json:"propagate" msgpack:"propagate"`
+}
+
+type APIWalletPrivateTransferBatchReply struct {
+	Results []*txs_builder.TxBuilderBatchResult `json:"results" msgpack:"results"`
+	Txs     []*transaction.Transaction          `json:"txs" msgpack:"txs"`
+}
+
+func (api *APICommon) WalletPrivateTransferBatch(r *http.Request, args *APIWalletPrivateTransferBatchRequest, reply *APIWalletPrivateTransferBatchReply, authenticated bool) (err error) {
+
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	if args.Data == nil {
+		return errors.New("Batch data is missing")
+	}
+
+	reply.Results, reply.Txs, err = txs_builder.TxsBuilder.CreateZetherBatchTxs(args.Data, args.Propagate, true, true, context.Background(), func(string) {})
+	return
+}
@@ -0,0 +1,30 @@
+package api_common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"pandora-pay/txs_builder"
+)
+
+type APIWalletPrivateTransferRequestRequest struct {
+	Data *txs_builder.TxBuilderCreateZetherTxData `json:"data" msgpack:"data"`
+}
+
+type APIWalletPrivateTransferRequestReply struct {
+	Request *txs_builder.TxBuilderZetherTxRequest `json:"request" msgpack:"request"`
+}
+
+func (api *APICommon) WalletPrivateTransferRequest(r *http.Request, args *APIWalletPrivateTransferRequestRequest, reply *APIWalletPrivateTransferRequestReply, authenticated bool) (err error) {
+
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	if args.Data == nil {
+		return errors.New("Transaction data is missing")
+	}
+
+	reply.Request, err = txs_builder.TxsBuilder.CreateZetherTxRequest(args.Data, nil, context.Background(), func(string) {})
+	return
+}
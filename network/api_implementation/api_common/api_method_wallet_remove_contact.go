@@ -0,0 +1,23 @@
+package api_common
+
+import (
+	"errors"
+	"net/http"
+)
+
+type APIWalletRemoveContactRequest struct {
+	Name string `json:"name" msgpack:"name"`
+}
+
+type APIWalletRemoveContactReply struct {
+	Status bool `json:"status" msgpack:"status"`
+}
+
+func (api *APICommon) WalletRemoveContact(r *http.Request, args *APIWalletRemoveContactRequest, reply *APIWalletRemoveContactReply, authenticated bool) (err error) {
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	reply.Status, err = api.wallet.RemoveContact(args.Name)
+	return
+}
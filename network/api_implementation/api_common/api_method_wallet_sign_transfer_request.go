@@ -0,0 +1,38 @@
+package api_common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"pandora-pay/helpers"
+	"pandora-pay/txs_builder"
+)
+
+type APIWalletSignTransferRequestRequest struct {
+	Request *txs_builder.TxBuilderZetherTxRequest `json:"request" msgpack:"request"`
+}
+
+type APIWalletSignTransferRequestReply struct {
+	Hash helpers.Base64 `json:"hash" msgpack:"hash"`
+	Tx   helpers.Base64 `json:"tx" msgpack:"tx"`
+}
+
+func (api *APICommon) WalletSignTransferRequest(r *http.Request, args *APIWalletSignTransferRequestRequest, reply *APIWalletSignTransferRequestReply, authenticated bool) error {
+
+	if !authenticated {
+		return errors.New("Invalid User or Password")
+	}
+
+	if args.Request == nil {
+		return errors.New("Transaction request is missing")
+	}
+
+	tx, err := txs_builder.TxsBuilder.SignZetherTxRequest(args.Request, context.Background(), func(string) {})
+	if err != nil {
+		return err
+	}
+
+	reply.Hash = tx.Bloom.Hash
+	reply.Tx = tx.Bloom.Serialized
+	return nil
+}
@@ -1,5 +1,7 @@
 package api_types
 
+import "pandora-pay/helpers"
+
 type APISubscriptionNotificationTxExtraBlockchain struct {
 	Inserted     bool   `json:"inserted,omitempty" msgpack:"inserted,omitempty"`
 	BlkHeight    uint64 `json:"blkHeight" msgpack:"blkHeight"`
@@ -43,8 +45,9 @@ type APISubscriptionNotificationAccountTxExtra struct {
 }
 
 type APISubscriptionNotificationTxExtraMempool struct {
-	Inserted bool `json:"inserted,omitempty" msgpack:"inserted,omitempty"`
-	Included bool `json:"included,omitempty" msgpack:"included,omitempty"`
+	Inserted   bool           `json:"inserted,omitempty" msgpack:"inserted,omitempty"`
+	Included   bool           `json:"included,omitempty" msgpack:"included,omitempty"`
+	ReplacedBy helpers.Base64 `json:"replacedBy,omitempty" msgpack:"replacedBy,omitempty"`
 }
 
 type APISubscriptionNotificationTxExtra struct {
@@ -49,8 +49,10 @@ func NewAPI(apiStore *api_common.APIStore, apiCommon *api_common.APICommon, chai
 		"tx-hash":                 api_code_http.Handle[api_common.APITxHashRequest, api_common.APITxHashReply](api.apiCommon.GetTxHash),
 		"tx":                      api_code_http.Handle[api_common.APITxRequest, api_common.APITxReply](api.apiCommon.GetTx),
 		"tx/exists":               api_code_http.Handle[api_common.APITxExistsRequest, api_common.APITxExistsReply](api.apiCommon.GetTxExists),
+		"tx/merkle-proof":         api_code_http.Handle[api_common.APITxMerkleProofRequest, api_common.APITxMerkleProofReply](api.apiCommon.GetTxMerkleProof),
 		"tx-raw":                  api_code_http.Handle[api_common.APITxRawRequest, api_common.APITxRawReply](api.apiCommon.GetTxRaw),
 		"account":                 api_code_http.Handle[api_common.APIAccountRequest, api_common.APIAccountReply](api.apiCommon.GetAccount),
+		"account/proof":           api_code_http.Handle[api_common.APIAccountProofRequest, api_common.APIAccountProofReply](api.apiCommon.GetAccountProof),
 		"accounts/count":          api_code_http.Handle[api_common.APIAccountsCountRequest, api_common.APIAccountsCountReply](api.apiCommon.GetAccountsCount),
 		"accounts/keys-by-index":  api_code_http.Handle[api_common.APIAccountsKeysByIndexRequest, api_common.APIAccountsKeysByIndexReply](api.apiCommon.GetAccountsKeysByIndex),
 		"accounts/by-keys":        api_code_http.Handle[api_common.APIAccountsByKeysRequest, api_common.APIAccountsByKeysReply](api.apiCommon.GetAccountsByKeys),
@@ -60,17 +62,30 @@ func NewAPI(apiStore *api_common.APIStore, apiCommon *api_common.APICommon, chai
 		"mempool":                 api_code_http.Handle[api_common.APIMempoolRequest, api_common.APIMempoolReply](api.apiCommon.GetMempool),
 		"mempool/tx-exists":       api_code_http.Handle[api_common.APIMempoolExistsRequest, api_common.APIMempoolExistsReply](api.apiCommon.GetMempoolExists),
 		"mempool/new-tx":          api_code_http.Handle[api_common.APIMempoolNewTxRequest, api_common.APIMempoolNewTxReply](api.apiCommon.MempoolNewTx),
+		"mempool/fee-estimate":    api_code_http.Handle[struct{}, api_common.APIMempoolFeeEstimateReply](api.apiCommon.GetMempoolFeeEstimate),
 		"network/nodes":           api_code_http.Handle[struct{}, api_common.APINetworkNodesReply](api.apiCommon.GetNetworkNodes),
+		"network/peers":           api_code_http.HandleAuthenticated[api_common.APINetworkPeersRequest, api_common.APINetworkPeersReply](api.apiCommon.GetNetworkPeers),
+		"network/connect":         api_code_http.HandleAuthenticated[api_common.APINetworkConnectRequest, api_common.APINetworkConnectReply](api.apiCommon.NetworkConnect),
+		"network/disconnect":      api_code_http.HandleAuthenticated[api_common.APINetworkDisconnectRequest, api_common.APINetworkStatusReply](api.apiCommon.NetworkDisconnect),
+		"network/ban":             api_code_http.HandleAuthenticated[api_common.APINetworkBanRequest, api_common.APINetworkBanReply](api.apiCommon.NetworkBan),
+		"network/unban":           api_code_http.HandleAuthenticated[api_common.APINetworkUnbanRequest, api_common.APINetworkStatusReply](api.apiCommon.NetworkUnban),
 		"wallet/get-addresses":    api_code_http.HandleAuthenticated[struct{}, api_common.APIWalletGetAccountsReply](api.apiCommon.GetWalletAddresses),
 		"wallet/generate-address": api_code_http.HandleAuthenticated[api_common.APIWalletGenerateAddressRequest, api_common.APIWalletGenerateAddressReply](api.apiCommon.GetWalletGenerateAddress),
 		"wallet/create-address":   api_code_http.HandleAuthenticated[api_common.APIWalletCreateAddressRequest, api_common.APIWalletCreateAddressReply](api.apiCommon.GetWalletCreateAddress),
 		"wallet/delete-address":   api_code_http.HandleAuthenticated[api_common.APIWalletDeleteAddressRequest, api_common.APIWalletDeleteAddressReply](api.apiCommon.GetWalletDeleteAddress),
 		"wallet/get-balances":     api_code_http.HandleAuthenticated[api_common.APIWalletGetBalanceRequest, api_common.APIWalletGetBalancesReply](api.apiCommon.GetWalletBalances),
 		"wallet/decrypt-tx":       api_code_http.HandleAuthenticated[api_common.APIWalletDecryptTxRequest, api_common.APIWalletDecryptTxReply](api.apiCommon.GetWalletDecryptTx),
+		"wallet/get-history":      api_code_http.HandleAuthenticated[api_common.APIWalletGetHistoryRequest, api_common.APIWalletGetHistoryReply](api.apiCommon.GetWalletHistory),
+		"wallet/get-contacts":     api_code_http.HandleAuthenticated[struct{}, api_common.APIWalletGetContactsReply](api.apiCommon.GetWalletContacts),
+		"wallet/add-contact":      api_code_http.HandleAuthenticated[api_common.APIWalletAddContactRequest, api_common.APIWalletAddContactReply](api.apiCommon.WalletAddContact),
+		"wallet/remove-contact":   api_code_http.HandleAuthenticated[api_common.APIWalletRemoveContactRequest, api_common.APIWalletRemoveContactReply](api.apiCommon.WalletRemoveContact),
 	}
 
 	api.PostMap = map[string]func(values io.ReadCloser) (interface{}, error){
-		"wallet/private-transfer": api_code_http.HandlePOSTAuthenticated[api_common.APIWalletPrivateTransferRequest, api_common.APIWalletPrivateTransferReply](api.apiCommon.WalletPrivateTransfer),
+		"wallet/private-transfer":         api_code_http.HandlePOSTAuthenticated[api_common.APIWalletPrivateTransferRequest, api_common.APIWalletPrivateTransferReply](api.apiCommon.WalletPrivateTransfer),
+		"wallet/private-transfer-batch":   api_code_http.HandlePOSTAuthenticated[api_common.APIWalletPrivateTransferBatchRequest, api_common.APIWalletPrivateTransferBatchReply](api.apiCommon.WalletPrivateTransferBatch),
+		"wallet/private-transfer-request": api_code_http.HandlePOSTAuthenticated[api_common.APIWalletPrivateTransferRequestRequest, api_common.APIWalletPrivateTransferRequestReply](api.apiCommon.WalletPrivateTransferRequest),
+		"wallet/sign-transfer-request":    api_code_http.HandlePOSTAuthenticated[api_common.APIWalletSignTransferRequestRequest, api_common.APIWalletSignTransferRequestReply](api.apiCommon.WalletSignTransferRequest),
 	}
 
 	if config.NODE_PROVIDE_EXTENDED_INFO_APP {
@@ -0,0 +1,78 @@
+package consensus
+
+import (
+	"errors"
+	"github.com/vmihailenco/msgpack/v5"
+	"net/http"
+	"pandora-pay/helpers"
+	"pandora-pay/network/network_config"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+	"strconv"
+)
+
+type APIBlockCompactRequest struct {
+	Height uint64         `json:"height,omitempty" msgpack:"height,omitempty"`
+	Hash   helpers.Base64 `json:"hash,omitempty" msgpack:"hash,omitempty"`
+}
+
+func (api *Consensus) loadBlockCompact(reader store_db_interface.StoreDBTransactionInterface, hash []byte) (*BlockCompact, error) {
+
+	blockData := reader.Get("block_ByHash" + string(hash))
+	if blockData == nil {
+		return nil, errors.New("Block was not found")
+	}
+
+	heightStr := reader.Get("blockHeight_ByHash" + string(hash))
+	if heightStr == nil {
+		return nil, errors.New("Block was not found by hash")
+	}
+
+	data := reader.Get("blockTxs" + string(heightStr))
+	if data == nil {
+		return nil, errors.New("Block not found")
+	}
+
+	txHashes := [][]byte{}
+	if err := msgpack.Unmarshal(data, &txHashes); err != nil {
+		return nil, err
+	}
+
+	compact := &BlockCompact{
+		Block:       helpers.CloneBytes(blockData),
+		TxsShortIds: make([][]byte, len(txHashes)),
+	}
+	for i, txHash := range txHashes {
+		compact.TxsShortIds[i] = helpers.CloneBytes(txHash[:network_config.BLOCK_COMPACT_TX_ID_SIZE])
+	}
+
+	return compact, nil
+}
+
+// OpenLoadBlockCompact returns the header and the short tx ids of a stored block
+func (api *Consensus) OpenLoadBlockCompact(hash []byte) (compact *BlockCompact, errFinal error) {
+	errFinal = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+		compact, err = api.loadBlockCompact(reader, hash)
+		return
+	})
+	return
+}
+
+func (api *Consensus) GetBlockCompact(r *http.Request, args *APIBlockCompactRequest, reply *BlockCompact) error {
+	return store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		if len(args.Hash) == 0 {
+			if args.Hash = reader.Get("blockHash_ByHeight" + strconv.FormatUint(args.Height, 10)); args.Hash == nil {
+				return errors.New("Block Hash not found")
+			}
+		}
+
+		var compact *BlockCompact
+		if compact, err = api.loadBlockCompact(reader, args.Hash); err != nil {
+			return
+		}
+
+		*reply = *compact
+		return
+	})
+}
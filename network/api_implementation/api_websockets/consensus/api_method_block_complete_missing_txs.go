@@ -47,6 +47,9 @@ func (api *Consensus) GetBlockCompleteMissingTxs(r *http.Request, args *APIBlock
 			if txMissingIndex >= 0 && txMissingIndex < len(txHashes) {
 				tx := reader.Get("tx:" + string(txHashes[txMissingIndex]))
 				if tx == nil {
+					if api.chain.IsTxPruned(reader, string(txHashes[txMissingIndex])) {
+						return errors.New("Tx was pruned")
+					}
 					return errors.New("Tx was not found")
 				}
 				reply.Txs[i] = tx
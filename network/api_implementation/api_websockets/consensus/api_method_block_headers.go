@@ -0,0 +1,48 @@
+package consensus
+
+import (
+	"errors"
+	"net/http"
+	"pandora-pay/config"
+	"pandora-pay/helpers"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+	"strconv"
+)
+
+type APIBlockHeadersRequest struct {
+	Start uint64 `json:"start,omitempty" msgpack:"start,omitempty"`
+	Count uint64 `json:"count,omitempty" msgpack:"count,omitempty"`
+}
+
+type APIBlockHeadersReply struct {
+	Headers [][]byte `json:"headers,omitempty" msgpack:"headers,omitempty"`
+}
+
+func (api *Consensus) GetBlockHeaders(r *http.Request, args *APIBlockHeadersRequest, reply *APIBlockHeadersReply) error {
+
+	if args.Count == 0 || args.Count > config.FORK_MAX_DOWNLOAD_HEADERS {
+		return errors.New("Invalid count")
+	}
+
+	return store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		reply.Headers = make([][]byte, 0, args.Count)
+		for height := args.Start; height < args.Start+args.Count; height++ {
+
+			hash := reader.Get("blockHash_ByHeight" + strconv.FormatUint(height, 10))
+			if hash == nil {
+				break
+			}
+
+			data := reader.Get("block_ByHash" + string(hash))
+			if data == nil {
+				return errors.New("Block was not found")
+			}
+
+			reply.Headers = append(reply.Headers, helpers.CloneBytes(data))
+		}
+
+		return
+	})
+}
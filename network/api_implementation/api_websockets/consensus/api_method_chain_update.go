@@ -44,6 +44,7 @@ func (consensus *Consensus) ChainUpdateProcess(conn *connection.AdvancedConnecti
 			Initialized:        false,
 			Blocks:             linked_list.NewLinkedList[*block_complete.BlockComplete](),
 			conns:              []*connection.AdvancedConnection{conn},
+			compact:            chainUpdateNotification.Compact,
 		}
 
 		consensus.forks.addFork(fork)
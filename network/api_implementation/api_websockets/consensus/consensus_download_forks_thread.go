@@ -12,12 +12,17 @@ import (
 	"pandora-pay/cryptography"
 	"pandora-pay/gui"
 	"pandora-pay/helpers/advanced_buffers"
+	"pandora-pay/helpers/generics"
+	"pandora-pay/helpers/recovery"
 	"pandora-pay/mempool"
 	"pandora-pay/network/api_code/api_code_types"
 	"pandora-pay/network/api_implementation/api_common"
+	"pandora-pay/network/network_config"
 	"pandora-pay/network/websocks/connection"
 	"pandora-pay/network/websocks/connection/advanced_connection_types"
 	"pandora-pay/txs_validator"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -40,49 +45,82 @@ func (thread *ConsensusProcessForksThread) downloadBlockHash(conn *connection.Ad
 	return answer.Hash, nil
 }
 
+func (thread *ConsensusProcessForksThread) downloadBlockCompact(conn *connection.AdvancedConnection, fork *Fork, height uint64) (*BlockCompact, error) {
+
+	//the compact block of the tip was already announced in the chain update
+	if fork.compact != nil && height+1 == fork.End {
+		return fork.compact, nil
+	}
+
+	return connection.SendJSONAwaitAnswer[BlockCompact](conn, []byte("block-compact"), &APIBlockCompactRequest{height, nil}, nil, 0)
+}
+
+func (thread *ConsensusProcessForksThread) downloadBlockCompleteFull(conn *connection.AdvancedConnection, height uint64) (*block_complete.BlockComplete, error) {
+
+	answer, err := connection.SendJSONAwaitAnswer[api_common.APIBlockCompleteReply](conn, []byte("block-complete"), &api_common.APIBlockCompleteRequest{height, nil, api_code_types.RETURN_SERIALIZED}, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	blkComplete := block_complete.CreateEmptyBlockComplete()
+	if err = blkComplete.Deserialize(advanced_buffers.NewBufferReader(answer.Serialized)); err != nil {
+		return nil, err
+	}
+
+	if err = txs_validator.TxsValidator.ValidateTxs(blkComplete.Txs); err != nil {
+		return nil, err
+	}
+
+	if err = blkComplete.BloomAll(); err != nil {
+		return nil, err
+	}
+
+	return blkComplete, nil
+}
+
+// downloadBlockComplete downloads the compact block and falls back to the full block for peers that don't support compact blocks
 func (thread *ConsensusProcessForksThread) downloadBlockComplete(conn *connection.AdvancedConnection, fork *Fork, height uint64) (*block_complete.BlockComplete, error) {
+	blkComplete, err := thread.downloadBlockCompleteCompact(conn, fork, height)
+	if err != nil {
+		return thread.downloadBlockCompleteFull(conn, height)
+	}
+	return blkComplete, nil
+}
+
+func (thread *ConsensusProcessForksThread) downloadBlockCompleteCompact(conn *connection.AdvancedConnection, fork *Fork, height uint64) (*block_complete.BlockComplete, error) {
 
-	blkWithTx, err := connection.SendJSONAwaitAnswer[api_common.APIBlockReply](conn, []byte("block"), &api_common.APIBlockRequest{height, nil, api_code_types.RETURN_SERIALIZED}, nil, 0)
+	compact, err := thread.downloadBlockCompact(conn, fork, height)
 	if err != nil {
 		return nil, err
 	}
 
-	blkWithTx.Block = block.CreateEmptyBlock()
-	if err = blkWithTx.Block.Deserialize(advanced_buffers.NewBufferReader(blkWithTx.BlockSerialized)); err != nil {
+	blk := block.CreateEmptyBlock()
+	if err = blk.Deserialize(advanced_buffers.NewBufferReader(compact.Block)); err != nil {
 		return nil, err
 	}
 
-	txsFound := 0
-	txs := make([]*transaction.Transaction, len(blkWithTx.Txs))
-	for i := range txs {
-		if tx := thread.mempool.Txs.Get(string(blkWithTx.Txs[i])); tx != nil {
-			txs[i] = tx.Tx
-			txsFound++
-			continue
+	if height+1 == fork.End && !bytes.Equal(blk.Bloom.Hash, fork.Hash) {
+		return nil, errors.New("Compact block hash is not matching")
+	}
+
+	for _, shortId := range compact.TxsShortIds {
+		if len(shortId) != network_config.BLOCK_COMPACT_TX_ID_SIZE {
+			return nil, errors.New("Compact block short id length is invalid")
 		}
 	}
 
-	blkComplete := block_complete.CreateEmptyBlockComplete()
-	blkComplete.Block = blkWithTx.Block
+	txs := thread.mempool.Txs.GetTxsByShortIds(compact.TxsShortIds)
 
-	missingTxsCount := 0
-	for _, tx := range txs {
+	missingTxs := make([]int, 0)
+	for i, tx := range txs {
 		if tx == nil {
-			missingTxsCount += 1
+			missingTxs = append(missingTxs, i)
 		}
 	}
 
-	if missingTxsCount > 0 {
-		missingTxs := make([]int, missingTxsCount)
-		c := 0
-		for i, tx := range txs {
-			if tx == nil {
-				missingTxs[c] = i
-				c++
-			}
-		}
+	if len(missingTxs) > 0 {
 
-		blkCompleteMissingTxs, err := connection.SendJSONAwaitAnswer[APIBlockCompleteMissingTxsReply](conn, []byte("block-miss-txs"), &APIBlockCompleteMissingTxsRequest{blkWithTx.Block.Bloom.Hash, missingTxs}, nil, 0)
+		blkCompleteMissingTxs, err := connection.SendJSONAwaitAnswer[APIBlockCompleteMissingTxsReply](conn, []byte("block-miss-txs"), &APIBlockCompleteMissingTxsRequest{blk.Bloom.Hash, missingTxs}, nil, 0)
 		if err != nil {
 			return nil, err
 		}
@@ -106,8 +144,15 @@ func (thread *ConsensusProcessForksThread) downloadBlockComplete(conn *connectio
 		}
 	}
 
+	blkComplete := block_complete.CreateEmptyBlockComplete()
+	blkComplete.Block = blk
 	blkComplete.Txs = txs
 
+	//a short id matched a different mempool tx, the full block is downloaded instead
+	if !bytes.Equal(blkComplete.MerkleHash(), blk.MerkleHash) {
+		return thread.downloadBlockCompleteFull(conn, height)
+	}
+
 	if err = txs_validator.TxsValidator.ValidateTxs(txs); err != nil {
 		return nil, err
 	}
@@ -171,38 +216,45 @@ func (thread *ConsensusProcessForksThread) downloadFork(fork *Fork) bool {
 			break
 		}
 
-		blkComplete, err := thread.downloadBlockComplete(conn, fork, start-1)
-		if err != nil {
-			fork.errors += 1
-			continue
-		}
+		start -= 1
+	}
 
-		if !bytes.Equal(blkComplete.Bloom.Hash, hash) { //it is not the same block
-			fork.errors += 1
-			continue
+	//the headers are chained starting from our common block
+	if start > 0 {
+		lastHeader, err := thread.loadBlockHeader(start - 1)
+		if err != nil {
+			return false
 		}
+		fork.lastHeader = lastHeader
+	}
 
-		//prepend
-		fork.Blocks.PushFront(blkComplete)
-
-		start -= 1
+	headersDifficulty, err := thread.chain.OpenLoadHeadersDifficulty(start)
+	if err != nil {
+		return false
 	}
+	fork.headersDifficulty = headersDifficulty
 
-	fork.Current = start + uint64(fork.Blocks.Length)
+	fork.Current = start
 
 	fork.Initialized = true
 
 	return true
 }
 
-func (thread *ConsensusProcessForksThread) downloadRemainingBlocks(fork *Fork) bool {
+// downloadHeaders downloads and validates the headers of the fork ahead of the blocks, so an invalid fork is rejected before downloading its txs
+// the total difficulty of the fork is known only after the last header, so the first time all the headers up to the end are validated, but only the first ones are kept
+func (thread *ConsensusProcessForksThread) downloadHeaders(fork *Fork) bool {
 
 	fork.Lock()
 	defer fork.Unlock()
 
-	for i := uint64(0); i < config.FORK_MAX_DOWNLOAD; i++ {
+	next := fork.Current + uint64(len(fork.headers))
+	lastHeader, headersDifficulty := fork.lastHeader, fork.headersDifficulty.Clone()
+
+	for next < fork.End {
 
-		if fork.Current == fork.End {
+		storing := uint64(len(fork.headers)) < config.FORK_MAX_DOWNLOAD_HEADERS
+		if !storing && fork.headersVerified {
 			break
 		}
 
@@ -218,17 +270,106 @@ func (thread *ConsensusProcessForksThread) downloadRemainingBlocks(fork *Fork) b
 			return false
 		}
 
-		blkComplete, err := thread.downloadBlockComplete(conn, fork, fork.Current)
-		if err != nil {
+		count := generics.Min(fork.End-next, config.FORK_MAX_DOWNLOAD_HEADERS)
+		if storing {
+			count = generics.Min(count, config.FORK_MAX_DOWNLOAD_HEADERS-uint64(len(fork.headers)))
+		}
+
+		answer, err := connection.SendJSONAwaitAnswer[APIBlockHeadersReply](conn, []byte("block-headers"), &APIBlockHeadersRequest{next, count}, nil, 0)
+		if err != nil || len(answer.Headers) == 0 || uint64(len(answer.Headers)) > count {
 			fork.errors += 1
 			continue
 		}
 
-		fork.Blocks.Push(blkComplete)
-		fork.Current += 1
+		headers, err := validateHeaders(lastHeader, headersDifficulty, next, answer.Headers)
+		if err != nil {
+			conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Invalid headers")
+			return false
+		}
+
+		lastHeader = headers[len(headers)-1]
+		next += uint64(len(headers))
+
+		if next == fork.End {
+
+			if !bytes.Equal(lastHeader.Bloom.Hash, fork.Hash) {
+				conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Headers are not matching the fork")
+				return false
+			}
+
+			bigTotalDifficulty := headersDifficulty.GetBigTotalDifficulty()
+			if bigTotalDifficulty.Cmp(fork.BigTotalDifficulty) != 0 {
+				conn.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Headers total difficulty is not matching the fork")
+				return false
+			}
+			if bigTotalDifficulty.Cmp(thread.chain.GetChainData().BigTotalDifficulty) <= 0 {
+				return false
+			}
+
+			fork.headersVerified = true
+		}
+
+		if storing {
+			fork.headers = append(fork.headers, headers...)
+			fork.lastHeader = lastHeader
+			fork.headersDifficulty = headersDifficulty.Clone()
+		}
+	}
+
+	return fork.headersVerified && len(fork.headers) > 0
+}
+
+// downloadRemainingBlocks downloads the blocks of the validated headers in parallel from the connections of the fork
+func (thread *ConsensusProcessForksThread) downloadRemainingBlocks(fork *Fork) bool {
+
+	fork.Lock()
+	defer fork.Unlock()
 
+	conns := fork.getConns()
+	if len(conns) == 0 {
+		return false
 	}
 
+	count := int(generics.Min(config.FORK_MAX_DOWNLOAD, uint64(len(fork.headers))))
+	blocks := make([]*block_complete.BlockComplete, count)
+
+	next := int32(-1)
+	wg := sync.WaitGroup{}
+	for worker := 0; worker < config.FORK_DOWNLOAD_PARALLEL && worker < count; worker++ {
+		wg.Add(1)
+		worker := worker
+		recovery.SafeGo(func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt32(&next, 1))
+				if i >= count {
+					return
+				}
+				header := fork.headers[i]
+				for tries := 0; tries < 3 && blocks[i] == nil; tries++ {
+					blkComplete, err := thread.downloadBlockComplete(conns[(worker+i+tries)%len(conns)], fork, header.Height)
+					if err == nil && bytes.Equal(blkComplete.Bloom.Hash, header.Bloom.Hash) {
+						blocks[i] = blkComplete
+					}
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	downloaded := 0
+	for downloaded < count && blocks[downloaded] != nil {
+		fork.Blocks.Push(blocks[downloaded])
+		downloaded += 1
+	}
+
+	if downloaded < count {
+		fork.errors += 1
+	}
+
+	fork.Current += uint64(downloaded)
+	fork.headers = fork.headers[downloaded:]
+
 	return fork.Blocks.Length > 0
 
 }
@@ -248,7 +389,7 @@ func (thread *ConsensusProcessForksThread) execute() {
 
 					globals.MainEvents.BroadcastEvent("consensus/update", fork)
 
-					if thread.downloadRemainingBlocks(fork) {
+					if thread.downloadHeaders(fork) && thread.downloadRemainingBlocks(fork) {
 
 						blocks := make([]*block_complete.BlockComplete, fork.Blocks.Length)
 						it := fork.Blocks.Head
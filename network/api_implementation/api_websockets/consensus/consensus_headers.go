@@ -0,0 +1,89 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"pandora-pay/blockchain"
+	"pandora-pay/blockchain/blocks/block"
+	"pandora-pay/config"
+	"pandora-pay/config/config_stake"
+	"pandora-pay/helpers"
+	"pandora-pay/helpers/advanced_buffers"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+	"time"
+)
+
+func (thread *ConsensusProcessForksThread) loadBlockHeader(height uint64) (blk *block.Block, errFinal error) {
+	errFinal = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		var hash []byte
+		if hash, err = thread.chain.LoadBlockHash(reader, height); err != nil {
+			return
+		}
+
+		data := reader.Get("block_ByHash" + string(hash))
+		if data == nil {
+			return errors.New("Block was not found")
+		}
+
+		blk = block.CreateEmptyBlock()
+		if err = blk.Deserialize(advanced_buffers.NewBufferReader(helpers.CloneBytes(data))); err != nil {
+			return
+		}
+
+		return blk.BloomNow()
+	})
+	return
+}
+
+// validateHeaders verifies that the headers are chained, that they were staked with enough coins and that their kernel hashes meet the targets replayed from the fork point.
+// The txs are verified by the blockchain when the blocks are inserted
+func validateHeaders(prev *block.Block, headersDifficulty *blockchain.HeadersDifficulty, start uint64, data [][]byte) ([]*block.Block, error) {
+
+	headers := make([]*block.Block, len(data))
+
+	for i := range data {
+
+		blk := block.CreateEmptyBlock()
+		if err := blk.Deserialize(advanced_buffers.NewBufferReader(data[i])); err != nil {
+			return nil, err
+		}
+		if err := blk.BloomNow(); err != nil {
+			return nil, err
+		}
+
+		if blk.Height != start+uint64(i) {
+			return nil, errors.New("Header height is invalid")
+		}
+
+		if blk.StakingAmount < config_stake.GetRequiredStake(blk.Height) {
+			return nil, errors.New("Header staked amount is not enough")
+		}
+
+		if blk.Timestamp > uint64(time.Now().UTC().Unix())+config.NETWORK_TIMESTAMP_DRIFT_MAX {
+			return nil, errors.New("Header timestamp is too much into the future")
+		}
+
+		if prev != nil {
+			if !bytes.Equal(blk.PrevHash, prev.Bloom.Hash) {
+				return nil, errors.New("Header prevHash is not matching")
+			}
+			if !bytes.Equal(blk.PrevKernelHash, prev.Bloom.KernelHash) {
+				return nil, errors.New("Header prevKernelHash is not matching")
+			}
+			if blk.Timestamp < prev.Timestamp {
+				return nil, errors.New("Header timestamp has to be greater than the previous one")
+			}
+		}
+
+		if err := headersDifficulty.Add(blk); err != nil {
+			return nil, err
+		}
+
+		headers[i] = blk
+		prev = blk
+	}
+
+	return headers, nil
+}
@@ -5,10 +5,18 @@ import (
 )
 
 type ChainUpdateNotification struct {
-	End                uint64   `json:"end" msgpack:"end"`
-	Hash               []byte   `json:"hash" msgpack:"hash"`
-	PrevHash           []byte   `json:"prevHash" msgpack:"prevHash"`
-	BigTotalDifficulty *big.Int `json:"bigTotalDifficulty" msgpack:"bigTotalDifficulty"`
+	End                uint64        `json:"end" msgpack:"end"`
+	Hash               []byte        `json:"hash" msgpack:"hash"`
+	PrevHash           []byte        `json:"prevHash" msgpack:"prevHash"`
+	BigTotalDifficulty *big.Int      `json:"bigTotalDifficulty" msgpack:"bigTotalDifficulty"`
+	Compact            *BlockCompact `json:"compact,omitempty" msgpack:"compact,omitempty"`
+}
+
+// BlockCompact is the serialized block header followed by the short ids of its txs.
+// The receivers rebuild the block from their mempool and download only the missing txs
+type BlockCompact struct {
+	Block       []byte   `json:"block" msgpack:"block"`
+	TxsShortIds [][]byte `json:"txsShortIds" msgpack:"txsShortIds"`
 }
 
 type ChainLastUpdate struct {
@@ -3,6 +3,8 @@ package consensus
 import (
 	"math/big"
 	"math/rand"
+	"pandora-pay/blockchain"
+	"pandora-pay/blockchain/blocks/block"
 	"pandora-pay/blockchain/blocks/block_complete"
 	"pandora-pay/helpers/linked_list"
 	"pandora-pay/network/websocks/connection"
@@ -19,6 +21,11 @@ type Fork struct {
 	HashStr            string                                                 `json:"hashStr" msgpack:"hashStr"`
 	PrevHash           []byte                                                 `json:"prevHash" msgpack:"prevHash"`
 	conns              []*connection.AdvancedConnection
+	compact            *BlockCompact
+	headers            []*block.Block //validated headers whose blocks were not downloaded yet
+	lastHeader         *block.Block
+	headersDifficulty  *blockchain.HeadersDifficulty //target of the header after lastHeader
+	headersVerified    bool                          //the headers were validated up to End
 	errors             int
 	sync.RWMutex       `json:"-" msgpack:"-"`
 }
@@ -39,6 +46,17 @@ func (fork *Fork) getRandomConn() (conn *connection.AdvancedConnection) {
 	return nil
 }
 
+//is locked before
+func (fork *Fork) getConns() []*connection.AdvancedConnection {
+	out := make([]*connection.AdvancedConnection, 0, len(fork.conns))
+	for _, conn := range fork.conns {
+		if !conn.IsClosed.IsSet() {
+			out = append(out, conn)
+		}
+	}
+	return out
+}
+
 func (fork *Fork) AddConn(conn *connection.AdvancedConnection, lock bool) {
 
 	if lock {
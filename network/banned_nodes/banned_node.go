@@ -10,4 +10,5 @@ type BannedNode struct {
 	Timestamp  time.Time
 	Expiration time.Time
 	Message    string
+	NotStored  bool
 }
@@ -3,6 +3,8 @@ package banned_nodes
 import (
 	"net/url"
 	"pandora-pay/helpers/generics"
+	"pandora-pay/helpers/recovery"
+	"pandora-pay/network/network_config"
 	"time"
 )
 
@@ -11,13 +13,17 @@ type BannedNodesType struct {
 }
 
 func (this *BannedNodesType) IsBanned(urlStr string) bool {
-	if _, found := this.bannedMap.Load(urlStr); found {
-		return true
+	if bannedNode, found := this.bannedMap.Load(urlStr); found {
+		if time.Now().Before(bannedNode.Expiration) {
+			return true
+		}
+		this.bannedMap.Delete(urlStr)
+		this.save()
 	}
 	return false
 }
 
-func (this *BannedNodesType) Ban(url *url.URL, urlStr, message string, duration time.Duration) {
+func (this *BannedNodesType) ban(url *url.URL, urlStr, message string, duration time.Duration, notStored bool) {
 	if urlStr == "" {
 		urlStr = url.String()
 	}
@@ -27,7 +33,78 @@ func (this *BannedNodesType) Ban(url *url.URL, urlStr, message string, duration
 		Message:    message,
 		Timestamp:  time,
 		Expiration: time.Add(duration),
+		NotStored:  notStored,
 	})
+	if !notStored {
+		this.save()
+	}
+}
+
+func (this *BannedNodesType) Ban(url *url.URL, urlStr, message string, duration time.Duration) {
+	this.ban(url, urlStr, message, duration, false)
+}
+
+// BanUntilRestart bans a node without storing the ban, like the addresses of the node itself
+func (this *BannedNodesType) BanUntilRestart(url *url.URL, urlStr, message string) {
+	this.ban(url, urlStr, message, 10*365*24*time.Hour, true)
+}
+
+func (this *BannedNodesType) Unban(urlStr string) bool {
+	if _, found := this.bannedMap.LoadAndDelete(urlStr); found {
+		this.save()
+		return true
+	}
+	return false
+}
+
+func (this *BannedNodesType) GetList() []*BannedNode {
+
+	now := time.Now()
+
+	list := make([]*BannedNode, 0)
+	this.bannedMap.Range(func(urlStr string, bannedNode *BannedNode) bool {
+		if now.Before(bannedNode.Expiration) {
+			list = append(list, bannedNode)
+		}
+		return true
+	})
+
+	return list
+}
+
+func (this *BannedNodesType) removeExpired() {
+
+	now := time.Now()
+
+	removed := false
+	this.bannedMap.Range(func(urlStr string, bannedNode *BannedNode) bool {
+		if !now.Before(bannedNode.Expiration) {
+			this.bannedMap.Delete(urlStr)
+			removed = true
+		}
+		return true
+	})
+
+	if removed {
+		this.save()
+	}
+}
+
+// Init loads the bans stored before the restart and removes the expired bans from time to time
+func (this *BannedNodesType) Init() (err error) {
+
+	if err = this.load(); err != nil {
+		return
+	}
+
+	recovery.SafeGo(func() {
+		for {
+			this.removeExpired()
+			time.Sleep(network_config.NETWORK_BANNED_NODES_EXPIRE_INTERVAL)
+		}
+	})
+
+	return
 }
 
 var BannedNodes *BannedNodesType
@@ -0,0 +1,92 @@
+package banned_nodes
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"net/url"
+	"pandora-pay/gui"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+	"time"
+)
+
+type bannedNodeStored struct {
+	URL        string `msgpack:"url"`
+	Timestamp  int64  `msgpack:"timestamp"`
+	Expiration int64  `msgpack:"expiration"`
+	Message    string `msgpack:"message"`
+	Host       bool   `msgpack:"host"` //hosts like ::1 are not valid urls
+}
+
+func (this *BannedNodesType) save() {
+
+	if store.StoreSettings == nil {
+		return
+	}
+
+	list := make([]*bannedNodeStored, 0)
+	this.bannedMap.Range(func(urlStr string, bannedNode *BannedNode) bool {
+		if bannedNode.NotStored {
+			return true
+		}
+		host := bannedNode.URL.Scheme == "" && bannedNode.URL.Opaque != ""
+		list = append(list, &bannedNodeStored{urlStr, bannedNode.Timestamp.Unix(), bannedNode.Expiration.Unix(), bannedNode.Message, host})
+		return true
+	})
+
+	data, err := msgpack.Marshal(list)
+	if err == nil {
+		err = store.StoreSettings.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) error {
+			writer.Put("bannedNodes", data)
+			return nil
+		})
+	}
+	if err != nil {
+		gui.GUI.Error("Error storing banned nodes", err)
+	}
+}
+
+func (this *BannedNodesType) load() error {
+	return store.StoreSettings.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		data := reader.Get("bannedNodes")
+		if data == nil {
+			return
+		}
+
+		list := make([]*bannedNodeStored, 0)
+		if err = msgpack.Unmarshal(data, &list); err != nil {
+			return
+		}
+
+		now := time.Now()
+		for _, it := range list {
+
+			expiration := time.Unix(it.Expiration, 0)
+			if !now.Before(expiration) {
+				continue
+			}
+
+			var bannedUrl *url.URL
+			if it.Host {
+				bannedUrl = &url.URL{Opaque: it.URL}
+			} else {
+				var errParse error
+				if bannedUrl, errParse = url.Parse(it.URL); errParse != nil {
+					gui.GUI.Warning("Invalid banned node", it.URL, errParse)
+					continue
+				}
+			}
+
+			this.bannedMap.Store(it.URL, &BannedNode{
+				URL:        bannedUrl,
+				Timestamp:  time.Unix(it.Timestamp, 0),
+				Expiration: expiration,
+				Message:    it.Message,
+			})
+		}
+
+		gui.GUI.Log("Banned nodes loaded", len(list))
+
+		return
+	})
+}
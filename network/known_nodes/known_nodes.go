@@ -36,6 +36,11 @@ func (this *KnownNodesType) GetList() []*known_node.KnownNodeScored {
 	return knownList
 }
 
+func (this *KnownNodesType) Get(url string) *known_node.KnownNodeScored {
+	knownNode, _ := this.knownMap.Load(url)
+	return knownNode
+}
+
 func (this *KnownNodesType) GetRandomKnownNode() *known_node.KnownNodeScored {
 	this.knownListMutex.RLock()
 	defer this.knownListMutex.RUnlock()
@@ -0,0 +1,96 @@
+package known_nodes
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"pandora-pay/gui"
+	"pandora-pay/helpers/recovery"
+	"pandora-pay/network/network_config"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+	"sync/atomic"
+	"time"
+)
+
+type knownNodeStored struct {
+	URL    string `msgpack:"url"`
+	IsSeed bool   `msgpack:"isSeed"`
+	Score  int32  `msgpack:"score"`
+}
+
+func (this *KnownNodesType) save() error {
+
+	list := this.GetList()
+
+	stored := make([]*knownNodeStored, len(list))
+	for i, knownNode := range list {
+		stored[i] = &knownNodeStored{knownNode.URL, knownNode.IsSeed, atomic.LoadInt32(&knownNode.Score)}
+	}
+
+	data, err := msgpack.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	return store.StoreSettings.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) error {
+		writer.Put("knownNodes", data)
+		return nil
+	})
+}
+
+func (this *KnownNodesType) load() error {
+
+	stored := make([]*knownNodeStored, 0)
+
+	if err := store.StoreSettings.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) error {
+		if data := reader.Get("knownNodes"); data != nil {
+			return msgpack.Unmarshal(data, &stored)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, it := range stored {
+
+		knownNode, found := this.knownMap.Load(it.URL)
+		if !found {
+			//the seeds are loaded from the config
+			if it.IsSeed {
+				continue
+			}
+			var err error
+			if knownNode, err = this.AddKnownNode(it.URL, false); err != nil {
+				continue
+			}
+		}
+
+		atomic.StoreInt32(&knownNode.Score, it.Score)
+
+		this.knownNotConnectedMaxHeapMutex.Lock()
+		this.knownNotConnectedMaxHeap.Update(float64(it.Score), []byte(it.URL))
+		this.knownNotConnectedMaxHeapMutex.Unlock()
+	}
+
+	gui.GUI.Log("Known nodes loaded", len(stored))
+
+	return nil
+}
+
+// Init restores the known nodes and their scores stored before the restart and saves them from time to time
+func (this *KnownNodesType) Init() (err error) {
+
+	if err = this.load(); err != nil {
+		return
+	}
+
+	recovery.SafeGo(func() {
+		for {
+			time.Sleep(network_config.NETWORK_KNOWN_NODES_SAVE_INTERVAL)
+			if err := this.save(); err != nil {
+				gui.GUI.Error("Error storing known nodes", err)
+			}
+		}
+	})
+
+	return
+}
@@ -6,6 +6,7 @@ import (
 	"pandora-pay/blockchain"
 	"pandora-pay/config"
 	"pandora-pay/mempool"
+	"pandora-pay/network/banned_nodes"
 	"pandora-pay/network/connected_nodes"
 	"pandora-pay/network/known_nodes"
 	"pandora-pay/network/server/node_tcp"
@@ -98,10 +99,18 @@ func NewNetwork(settings *settings.Settings, chain *blockchain.Blockchain, mempo
 	for i, seed := range config.NETWORK_SELECTED_SEEDS {
 		list[i] = seed.Url
 	}
+	if err := banned_nodes.BannedNodes.Init(); err != nil {
+		return err
+	}
+
 	if err := known_nodes.KnownNodes.Reset(list, true); err != nil {
 		return err
 	}
 
+	if err := known_nodes.KnownNodes.Init(); err != nil {
+		return err
+	}
+
 	if err := node_tcp.NewTcpServer(settings, chain, mempool, wallet); err != nil {
 		return err
 	}
@@ -109,5 +118,6 @@ func NewNetwork(settings *settings.Settings, chain *blockchain.Blockchain, mempo
 	Network = &networkType{}
 
 	Network.continuouslyConnectingNewPeers()
+	Network.initCLI()
 	return nil
 }
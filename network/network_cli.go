@@ -0,0 +1,100 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"pandora-pay/gui"
+	"pandora-pay/network/banned_nodes"
+	"pandora-pay/network/websocks"
+	"pandora-pay/network/websocks/connection/advanced_connection_types"
+	"time"
+)
+
+func (this *networkType) initCLI() {
+
+	cliListPeers := func(cmd string, ctx context.Context) (err error) {
+
+		peers := websocks.Websockets.GetPeers()
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Connected Peers: %d", len(peers)))
+		for _, peer := range peers {
+			direction := "out"
+			if peer.Incoming {
+				direction = "in"
+			}
+			gui.GUI.OutputWrite(fmt.Sprintf("%6d %3s %5d %8s %s %s", peer.UUID, direction, peer.Score, peer.Version, peer.RemoteAddr, peer.URL))
+		}
+
+		banned := banned_nodes.BannedNodes.GetList()
+		if len(banned) > 0 {
+			gui.GUI.OutputWrite(fmt.Sprintf("Banned Nodes: %d", len(banned)))
+			for _, bannedNode := range banned {
+				gui.GUI.OutputWrite(fmt.Sprintf("%s until %s %s", bannedNode.URL.String(), bannedNode.Expiration.UTC().Format(time.RFC822), bannedNode.Message))
+			}
+		}
+
+		return
+	}
+
+	cliConnectPeer := func(cmd string, ctx context.Context) (err error) {
+
+		url := gui.GUI.OutputReadString("Node url. Example: ws://127.0.0.1:5230/ws")
+
+		conn, err := websocks.Websockets.ConnectPeer(url)
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Connected to %s with UUID %d", url, conn.UUID))
+		return
+	}
+
+	cliDisconnectPeer := func(cmd string, ctx context.Context) (err error) {
+
+		uuid := gui.GUI.OutputReadUint64("Peer UUID", false, 0, nil)
+
+		if !websocks.Websockets.DisconnectPeer(advanced_connection_types.UUID(uuid)) {
+			gui.GUI.OutputWrite("Peer was not found")
+			return
+		}
+
+		gui.GUI.OutputWrite("Peer disconnected")
+		return
+	}
+
+	cliBanPeer := func(cmd string, ctx context.Context) (err error) {
+
+		url := gui.GUI.OutputReadString("Node url or ip")
+		reason := gui.GUI.OutputReadString("Reason")
+		duration := gui.GUI.OutputReadUint64("Duration in minutes. Leave empty for 1 day", true, 24*60, func(value uint64) bool {
+			return value > 0
+		})
+
+		disconnected, err := websocks.Websockets.BanPeer(url, reason, time.Duration(duration)*time.Minute)
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Node banned. Disconnected %d peers", disconnected))
+		return
+	}
+
+	cliUnbanPeer := func(cmd string, ctx context.Context) (err error) {
+
+		url := gui.GUI.OutputReadString("Node url or ip")
+
+		if !websocks.Websockets.UnbanPeer(url) {
+			gui.GUI.OutputWrite("Node was not banned")
+			return
+		}
+
+		gui.GUI.OutputWrite("Node unbanned")
+		return
+	}
+
+	gui.GUI.CommandDefineCallback("List Peers", cliListPeers, true)
+	gui.GUI.CommandDefineCallback("Connect Peer", cliConnectPeer, true)
+	gui.GUI.CommandDefineCallback("Disconnect Peer", cliDisconnectPeer, true)
+	gui.GUI.CommandDefineCallback("Ban Peer", cliBanPeer, true)
+	gui.GUI.CommandDefineCallback("Unban Peer", cliUnbanPeer, true)
+}
@@ -29,6 +29,35 @@ const (
 	WEBSOCKETS_INCREASE_KNOWN_NODE_SCORE_INTERVAL = 1 * time.Minute
 	WEBSOCKETS_CONCURRENT_NEW_CONENCTIONS         = 5
 	WEBSOCKETS_TIMEOUT                            = 15 * time.Second //seconds
+	NETWORK_KNOWN_NODES_SAVE_INTERVAL             = 1 * time.Minute
+	NETWORK_BANNED_NODES_EXPIRE_INTERVAL          = 1 * time.Minute
+	BLOCK_COMPACT_TX_ID_SIZE                      = 8
+	WEBSOCKETS_TXS_INVENTORY_DELAY                = 250 * time.Millisecond
+	WEBSOCKETS_TXS_INVENTORY_MAX                  = 500
+)
+
+type RateLimit struct {
+	Rate  float64 //requests per second
+	Burst float64
+}
+
+var (
+	WEBSOCKETS_RATE_LIMIT_DEFAULT = &RateLimit{100, 500}
+	WEBSOCKETS_RATE_LIMITS        = map[string]*RateLimit{
+		"mempool/new-tx":    {20, 100},
+		"mempool/new-tx-id": {50, 300},
+		"mempool/inv":       {20, 100},
+		"block-complete":    {30, 300},
+		"block-compact":     {30, 300},
+		"block-headers":     {5, 50},
+		"accounts/by-keys":  {10, 50},
+	}
+	WEBSOCKETS_FEATURE_MEMPOOL_INV  = "mempool/inv"
+	WEBSOCKETS_FEATURES             = []string{WEBSOCKETS_FEATURE_MEMPOOL_INV}
+	WEBSOCKETS_PENALTY_RATE_LIMIT   = int32(1)
+	WEBSOCKETS_PENALTY_INVALID_DATA = int32(50)
+	WEBSOCKETS_BAN_SCORE_THRESHOLD  = int32(-300)
+	WEBSOCKETS_BAN_DURATION         = 1 * time.Hour
 )
 
 func InitConfig() (err error) {
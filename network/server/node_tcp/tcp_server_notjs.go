@@ -24,7 +24,6 @@ import (
 	"pandora-pay/wallet"
 	"path"
 	"strconv"
-	"time"
 )
 
 type tcpServerType struct {
@@ -79,7 +78,7 @@ func NewTcpServer(settings *settings.Settings, chain *blockchain.Blockchain, mem
 		}
 	}
 
-	banned_nodes.BannedNodes.Ban(&url.URL{Scheme: "ws", Host: address + ":" + port, Path: "/ws"}, "", "You can't connect to yourself", 10*365*24*time.Hour)
+	banned_nodes.BannedNodes.BanUntilRestart(&url.URL{Scheme: "ws", Host: address + ":" + port, Path: "/ws"}, "", "You can't connect to yourself")
 
 	var certPath, keyPath string
 	if arguments.Arguments["--tcp-server-tls-cert-file"] != nil {
@@ -152,7 +151,7 @@ func NewTcpServer(settings *settings.Settings, chain *blockchain.Blockchain, mem
 		network_config.NETWORK_ADDRESS_URL_STRING = u.String()
 		network_config.NETWORK_WEBSOCKET_ADDRESS_URL_STRING = websocketUrl.String()
 
-		banned_nodes.BannedNodes.Ban(websocketUrl, "", "You can't connect to yourself", 10*365*24*time.Hour)
+		banned_nodes.BannedNodes.BanUntilRestart(websocketUrl, "", "You can't connect to yourself")
 		TcpServer.URL = u
 		TcpServer.Address = u.Host
 	}
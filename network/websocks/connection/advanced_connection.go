@@ -49,12 +49,20 @@ type AdvancedConnection struct {
 	ConnectionType           bool
 	onClosedConnection       func(c *AdvancedConnection)
 	onIncreaseKnownNodeScore func(knownNode *known_node.KnownNodeScored, delta int32, isServer bool) bool
+	onPenalty                func(c *AdvancedConnection, penalty int32, reason string)
+	rateLimiter              *RateLimiter
+	Penalty                  int32 //misbehaviour score of the connection, use atomic
 }
 
 func (c *AdvancedConnection) GetTimeout() time.Duration {
 	return network_config.WEBSOCKETS_TIMEOUT
 }
 
+// Penalize increases the misbehaviour score of the connection. The peer gets banned when the score is too high
+func (c *AdvancedConnection) Penalize(penalty int32, reason string) {
+	c.onPenalty(c, penalty, reason)
+}
+
 func (c *AdvancedConnection) Close() error {
 	if c.IsClosed.SetToIf(false, true) {
 		close(c.Closed)
@@ -190,8 +198,13 @@ func (c *AdvancedConnection) get(message *advanced_connection_types.AdvancedConn
 
 	route := string(message.Name)
 	if callback := c.getMap[route]; callback != nil {
-		output, err = callback(c, message.Data)
-	} else {
+		if !c.Authenticated.IsSet() && !c.rateLimiter.Allow(route) {
+			c.Penalize(network_config.WEBSOCKETS_PENALTY_RATE_LIMIT, "Rate limit exceeded")
+			err = errors.New("Rate limit exceeded")
+		} else {
+			output, err = callback(c, message.Data)
+		}
+	} else { //peers running other versions may know more routes
 		err = errors.New("Unknown request")
 	}
 
@@ -271,6 +284,8 @@ func (c *AdvancedConnection) ReadPump() {
 			message := &advanced_connection_types.AdvancedConnectionMessage{}
 			if err = msgpack.Unmarshal(read, message); err == nil && message != nil {
 				c.processRead(message)
+			} else {
+				c.Penalize(network_config.WEBSOCKETS_PENALTY_INVALID_DATA, "Invalid message")
 			}
 		})
 
@@ -329,7 +344,7 @@ func (c *AdvancedConnection) IncreaseKnownNodeScore() {
 
 }
 
-func NewAdvancedConnection(conn *websock.Conn, remoteAddr string, knownNode *known_node.KnownNodeScored, getMap map[string]func(conn *AdvancedConnection, values []byte) (any, error), connectionType bool, newSubscriptionCn, removeSubscriptionCn chan<- *SubscriptionNotification, onClosedConnection func(*AdvancedConnection), onIncreaseKnownNodeScore func(*known_node.KnownNodeScored, int32, bool) bool) (*AdvancedConnection, error) {
+func NewAdvancedConnection(conn *websock.Conn, remoteAddr string, knownNode *known_node.KnownNodeScored, getMap map[string]func(conn *AdvancedConnection, values []byte) (any, error), connectionType bool, newSubscriptionCn, removeSubscriptionCn chan<- *SubscriptionNotification, onClosedConnection func(*AdvancedConnection), onIncreaseKnownNodeScore func(*known_node.KnownNodeScored, int32, bool) bool, onPenalty func(*AdvancedConnection, int32, string)) (*AdvancedConnection, error) {
 
 	//making sure u is not collided with UUID_ALL and UUID_SKIP_ALL
 	uuid := advanced_connection_types.UUID(atomic.AddUint32(&uuidGenerator, 1))
@@ -358,6 +373,9 @@ func NewAdvancedConnection(conn *websock.Conn, remoteAddr string, knownNode *kno
 		connectionType,
 		onClosedConnection,
 		onIncreaseKnownNodeScore,
+		onPenalty,
+		NewRateLimiter(),
+		0,
 	}
 	advancedConnection.Subscriptions = NewSubscriptions(advancedConnection, newSubscriptionCn, removeSubscriptionCn)
 	return advancedConnection, nil
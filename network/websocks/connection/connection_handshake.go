@@ -12,6 +12,17 @@ type ConnectionHandshake struct {
 	Network   uint64                   `json:"network" msgpack:"network"`
 	Consensus config.NodeConsensusType `json:"consensus" msgpack:"consensus"`
 	URL       string                   `json:"url" msgpack:"url"`
+	Features  []string                 `json:"features,omitempty" msgpack:"features,omitempty"`
+}
+
+// HasFeature returns if the peer announced the feature. Older peers don't announce any feature
+func (handshake *ConnectionHandshake) HasFeature(feature string) bool {
+	for _, it := range handshake.Features {
+		if it == feature {
+			return true
+		}
+	}
+	return false
 }
 
 func (handshake *ConnectionHandshake) ValidateHandshake() (*semver.Version, error) {
@@ -0,0 +1,54 @@
+package connection
+
+import (
+	"pandora-pay/helpers/generics"
+	"pandora-pay/network/network_config"
+	"sync"
+	"time"
+)
+
+type rateLimiterBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// RateLimiter is a token bucket limiter with one bucket for every route
+type RateLimiter struct {
+	buckets map[string]*rateLimiterBucket
+	lock    sync.Mutex
+}
+
+func (limiter *RateLimiter) Allow(route string) bool {
+
+	limit := network_config.WEBSOCKETS_RATE_LIMITS[route]
+	if limit == nil {
+		limit = network_config.WEBSOCKETS_RATE_LIMIT_DEFAULT
+	}
+
+	now := time.Now()
+
+	limiter.lock.Lock()
+	defer limiter.lock.Unlock()
+
+	bucket := limiter.buckets[route]
+	if bucket == nil {
+		bucket = &rateLimiterBucket{limit.Burst, now}
+		limiter.buckets[route] = bucket
+	} else {
+		bucket.tokens = generics.Min(limit.Burst, bucket.tokens+now.Sub(bucket.updated).Seconds()*limit.Rate)
+		bucket.updated = now
+	}
+
+	if bucket.tokens < 1 {
+		return false
+	}
+
+	bucket.tokens -= 1
+	return true
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets: make(map[string]*rateLimiterBucket),
+	}
+}
@@ -0,0 +1,21 @@
+package connection
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pandora-pay/network/network_config"
+	"testing"
+)
+
+func TestRateLimiter(t *testing.T) {
+
+	limiter := NewRateLimiter()
+
+	limit := network_config.WEBSOCKETS_RATE_LIMITS["mempool/new-tx"]
+	for i := 0; i < int(limit.Burst); i++ {
+		assert.True(t, limiter.Allow("mempool/new-tx"), "Request should be allowed")
+	}
+	assert.False(t, limiter.Allow("mempool/new-tx"), "Burst should be exceeded")
+
+	//other routes have their own bucket
+	assert.True(t, limiter.Allow("block-complete"), "Request should be allowed")
+}
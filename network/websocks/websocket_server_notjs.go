@@ -4,8 +4,10 @@
 package websocks
 
 import (
+	"net"
 	"net/http"
 	"pandora-pay/helpers/recovery"
+	"pandora-pay/network/banned_nodes"
 	"pandora-pay/network/connected_nodes"
 	"pandora-pay/network/known_nodes"
 	"pandora-pay/network/network_config"
@@ -20,6 +22,11 @@ func (this *websocketsType) HandleUpgradeConnection(w http.ResponseWriter, r *ht
 		return
 	}
 
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil && banned_nodes.BannedNodes.IsBanned(host) {
+		http.Error(w, "Banned", 403)
+		return
+	}
+
 	c, err := websock.Upgrade(w, r)
 	if err != nil {
 		return
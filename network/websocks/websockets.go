@@ -176,7 +176,7 @@ func (this *websocketsType) increaseScoreKnownNode(knownNode *known_node.KnownNo
 
 func (this *websocketsType) NewConnection(c *websock.Conn, remoteAddr string, knownNode *known_node.KnownNodeScored, connectionType bool) (*connection.AdvancedConnection, error) {
 
-	conn, err := connection.NewAdvancedConnection(c, remoteAddr, knownNode, this.apiGetMap, connectionType, this.subscriptions.newSubscriptionCn, this.subscriptions.removeSubscriptionCn, this.closedConnection, this.increaseScoreKnownNode)
+	conn, err := connection.NewAdvancedConnection(c, remoteAddr, knownNode, this.apiGetMap, connectionType, this.subscriptions.newSubscriptionCn, this.subscriptions.removeSubscriptionCn, this.closedConnection, this.increaseScoreKnownNode, this.penalizeConnection)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,166 @@
+package websocks
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"pandora-pay/config"
+	"pandora-pay/gui"
+	"pandora-pay/network/banned_nodes"
+	"pandora-pay/network/known_nodes"
+	"pandora-pay/network/network_config"
+	"pandora-pay/network/websocks/connection"
+	"pandora-pay/network/websocks/connection/advanced_connection_types"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type PeerInfo struct {
+	UUID       advanced_connection_types.UUID `json:"uuid" msgpack:"uuid"`
+	RemoteAddr string                         `json:"remoteAddr" msgpack:"remoteAddr"`
+	URL        string                         `json:"url" msgpack:"url"`
+	Name       string                         `json:"name" msgpack:"name"`
+	Version    string                         `json:"version" msgpack:"version"`
+	Consensus  config.NodeConsensusType       `json:"consensus" msgpack:"consensus"`
+	Score      int32                          `json:"score" msgpack:"score"`
+	Incoming   bool                           `json:"incoming" msgpack:"incoming"`
+}
+
+func (this *websocketsType) GetPeers() []*PeerInfo {
+
+	list := this.GetAllSockets()
+
+	peers := make([]*PeerInfo, len(list))
+	for i, conn := range list {
+		peers[i] = &PeerInfo{
+			UUID:       conn.UUID,
+			RemoteAddr: conn.RemoteAddr,
+			Incoming:   conn.ConnectionType,
+		}
+		if conn.Handshake != nil {
+			peers[i].URL = conn.Handshake.URL
+			peers[i].Name = conn.Handshake.Name
+			peers[i].Version = conn.Handshake.Version
+			peers[i].Consensus = conn.Handshake.Consensus
+		}
+		if conn.KnownNode != nil {
+			peers[i].Score = atomic.LoadInt32(&conn.KnownNode.Score)
+		}
+	}
+
+	return peers
+}
+
+// ConnectPeer connects manually to a node. The url is added to the known nodes
+func (this *websocketsType) ConnectPeer(urlStr string) (*connection.AdvancedConnection, error) {
+
+	if _, err := url.ParseRequestURI(urlStr); err != nil {
+		return nil, errors.New("Invalid url")
+	}
+
+	if banned_nodes.BannedNodes.IsBanned(urlStr) {
+		return nil, errors.New("url is banned")
+	}
+
+	knownNode := known_nodes.KnownNodes.Get(urlStr)
+	if knownNode == nil {
+		var err error
+		if knownNode, err = known_nodes.KnownNodes.AddKnownNode(urlStr, false); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := this.NewWebsocketClient(knownNode)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.conn, nil
+}
+
+func (this *websocketsType) DisconnectPeer(uuid advanced_connection_types.UUID) bool {
+	for _, conn := range this.GetAllSockets() {
+		if conn.UUID == uuid {
+			conn.Close()
+			return true
+		}
+	}
+	return false
+}
+
+// BanPeer bans the url or the ip and closes the connections to it. An ip is banned like the incoming connections are banned by penalizeConnection
+func (this *websocketsType) BanPeer(urlStr, reason string, duration time.Duration) (int, error) {
+
+	if duration <= 0 {
+		return 0, errors.New("Invalid ban duration")
+	}
+
+	var host string
+	if ip := net.ParseIP(strings.Trim(urlStr, "[]")); ip != nil {
+		host = ip.String()
+		banned_nodes.BannedNodes.Ban(&url.URL{Opaque: host}, host, reason, duration)
+	} else if bannedUrl, err := url.ParseRequestURI(urlStr); err == nil && bannedUrl.Scheme != "" {
+		banned_nodes.BannedNodes.Ban(bannedUrl, urlStr, reason, duration)
+	} else {
+		return 0, errors.New("Invalid url or ip")
+	}
+
+	disconnected := 0
+	for _, conn := range this.GetAllSockets() {
+
+		matched := (conn.Handshake != nil && conn.Handshake.URL == urlStr) || (conn.KnownNode != nil && conn.KnownNode.URL == urlStr)
+		if conn.ConnectionType {
+			if connHost, _, err := net.SplitHostPort(conn.RemoteAddr); err == nil && host != "" && connHost == host {
+				matched = true
+			}
+		} else if conn.RemoteAddr == urlStr {
+			matched = true
+		}
+
+		if matched {
+			conn.Close()
+			disconnected++
+		}
+	}
+
+	return disconnected, nil
+}
+
+func (this *websocketsType) UnbanPeer(urlStr string) bool {
+	if ip := net.ParseIP(strings.Trim(urlStr, "[]")); ip != nil {
+		urlStr = ip.String()
+	}
+	return banned_nodes.BannedNodes.Unban(urlStr)
+}
+
+func (this *websocketsType) penalizeConnection(conn *connection.AdvancedConnection, penalty int32, reason string) {
+
+	//the known node score is shared by all connections and only ranks the nodes, the ban depends only on the misbehaviour of this connection
+	score := -atomic.AddInt32(&conn.Penalty, penalty)
+	if conn.KnownNode != nil {
+		known_nodes.KnownNodes.DecreaseKnownNodeScore(conn.KnownNode, -penalty, conn.ConnectionType)
+	}
+
+	if score > network_config.WEBSOCKETS_BAN_SCORE_THRESHOLD {
+		return
+	}
+
+	if conn.Handshake != nil && conn.Handshake.URL != "" {
+		if bannedUrl, err := url.Parse(conn.Handshake.URL); err == nil {
+			banned_nodes.BannedNodes.Ban(bannedUrl, conn.Handshake.URL, reason, network_config.WEBSOCKETS_BAN_DURATION)
+		}
+	}
+
+	if conn.ConnectionType {
+		//incoming connections are banned by ip as the url is optional
+		if host, _, err := net.SplitHostPort(conn.RemoteAddr); err == nil {
+			banned_nodes.BannedNodes.Ban(&url.URL{Opaque: host}, host, reason, network_config.WEBSOCKETS_BAN_DURATION)
+		}
+	} else if bannedUrl, err := url.Parse(conn.RemoteAddr); err == nil {
+		banned_nodes.BannedNodes.Ban(bannedUrl, conn.RemoteAddr, reason, network_config.WEBSOCKETS_BAN_DURATION)
+	}
+
+	gui.GUI.Log("Peer banned", conn.RemoteAddr, reason)
+	conn.Close()
+}
@@ -270,7 +270,7 @@ func (this *WebsocketSubscriptions) processSubscriptions() {
 
 			if list := this.transactionsSubscriptions[txUpdate.Tx.Bloom.HashStr]; list != nil {
 				this.send(api_code_types.SUBSCRIPTION_TRANSACTION, []byte("sub/notify"), txUpdate.Tx.Bloom.Hash, list, nil, nil, &api_types.APISubscriptionNotificationTxExtra{
-					Mempool: &api_types.APISubscriptionNotificationTxExtraMempool{txUpdate.Inserted, txUpdate.IncludedInBlockchainNotification},
+					Mempool: &api_types.APISubscriptionNotificationTxExtraMempool{txUpdate.Inserted, txUpdate.IncludedInBlockchainNotification, txUpdate.ReplacedBy},
 				})
 			}
 
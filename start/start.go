@@ -19,6 +19,7 @@ import (
 	"pandora-pay/cryptography/crypto/balance_decryptor"
 	"pandora-pay/gui"
 	"pandora-pay/helpers/debugging_pprof"
+	"pandora-pay/helpers/recovery"
 	"pandora-pay/mempool"
 	"pandora-pay/network"
 	"pandora-pay/network/network_config"
@@ -155,6 +156,14 @@ func StartMainNow() (err error) {
 
 	chain_network.InitChainNetwork(app.Chain, app.Mempool)
 
+	if runtime.GOARCH != "wasm" && config.NODE_CONSENSUS == config.NODE_CONSENSUS_TYPE_FULL {
+		recovery.SafeGo(func() {
+			if err := app.Mempool.LoadSavedTxs(app.Chain.GetChainData().Height); err != nil {
+				gui.GUI.Error("Error loading the saved mempool txs", err)
+			}
+		})
+	}
+
 	gui.GUI.Log("Main Loop")
 	globals.MainEvents.BroadcastEvent("main", "initialized")
 
@@ -7,6 +7,7 @@ import (
 	"pandora-pay/helpers"
 	"pandora-pay/helpers/advanced_buffers"
 	"pandora-pay/helpers/generics"
+	"pandora-pay/store/state_tree"
 	"pandora-pay/store/store_db/store_db_interface"
 	"strconv"
 )
@@ -26,6 +27,7 @@ type HashMap[T HashMapElementSerializableInterface] struct {
 	DeletedEvent   func(key []byte) error
 	StoredEvent    func(key []byte, committed *CommittedMapElement[T], index uint64) error
 	Indexable      bool
+	Authenticated  bool //committed elements are included in the state tree
 }
 
 func (hashMap *HashMap[T]) deserialize(key, data []byte, index uint64) (T, error) {
@@ -103,6 +105,61 @@ func (hashMap *HashMap[T]) GetRandom() (T, error) {
 	return hashMap.GetByIndex(index)
 }
 
+// support only for commited data
+func (hashMap *HashMap[T]) Range(callback func(key []byte, element T) (bool, error)) (err error) {
+
+	if hashMap.changed {
+		return errors.New("Range is supported only when is committed")
+	}
+
+	prefix := hashMap.name + ":map:"
+
+	hashMap.Tx.Range(prefix, func(key string, value []byte) bool {
+
+		var index uint64
+		if hashMap.Indexable {
+			//safe because the bytes will be converted into an integer
+			data := hashMap.Tx.Get(hashMap.name + ":listKeys:" + key[len(prefix):])
+			if data == nil {
+				err = errors.New("Key not found")
+				return false
+			}
+			if index, err = strconv.ParseUint(string(data), 10, 64); err != nil {
+				return false
+			}
+		}
+
+		var element T
+		if element, err = hashMap.deserialize([]byte(key[len(prefix):]), value, index); err != nil {
+			return false
+		}
+
+		var next bool
+		if next, err = callback([]byte(key[len(prefix):]), element); err != nil {
+			return false
+		}
+		return next
+	})
+
+	return
+}
+
+func (hashMap *HashMap[T]) StateLeafKey(key string) []byte {
+	return state_tree.LeafKey(hashMap.name, key)
+}
+
+// support only for commited data
+func (hashMap *HashMap[T]) ComputeStateLeaves(leaves map[string][]byte) {
+
+	prefix := hashMap.name + ":map:"
+
+	hashMap.Tx.Range(prefix, func(key string, value []byte) bool {
+		leafKey := hashMap.StateLeafKey(key[len(prefix):])
+		leaves[string(leafKey)] = state_tree.LeafHash(leafKey, value)
+		return true
+	})
+}
+
 func (hashMap *HashMap[T]) Get(key string) (out T, err error) {
 
 	if hashMap.keyLength != 0 && len(key) != hashMap.keyLength {
@@ -325,6 +382,11 @@ func (hashMap *HashMap[T]) CommitChanges() (err error) {
 
 	removed := make([]string, len(hashMap.Changes))
 
+	var leaves map[string][]byte
+	if hashMap.Authenticated && hashMap.Tx.IsWritable() {
+		leaves = make(map[string][]byte)
+	}
+
 	c := 0
 	for k, v := range hashMap.Changes {
 		if hashMap.keyLength != 0 && len(k) != hashMap.keyLength {
@@ -364,6 +426,9 @@ func (hashMap *HashMap[T]) CommitChanges() (err error) {
 						hashMap.Tx.Delete(hashMap.name + ":listKeys:" + k)
 					}
 
+					if leaves != nil {
+						leaves[string(hashMap.StateLeafKey(k))] = nil
+					}
 				}
 
 				if hashMap.DeletedEvent != nil {
@@ -423,6 +488,11 @@ func (hashMap *HashMap[T]) CommitChanges() (err error) {
 			if hashMap.Tx.IsWritable() {
 				//clone required because the element could change later on
 				hashMap.Tx.Put(hashMap.name+":map:"+k, committed.serialized)
+
+				if leaves != nil {
+					leafKey := hashMap.StateLeafKey(k)
+					leaves[string(leafKey)] = state_tree.LeafHash(leafKey, committed.serialized)
+				}
 			}
 
 			committed.Status = "view"
@@ -436,6 +506,10 @@ func (hashMap *HashMap[T]) CommitChanges() (err error) {
 		delete(hashMap.Changes, removed[i])
 	}
 
+	if err = state_tree.NewStateTree(hashMap.Tx).Update(leaves); err != nil {
+		return
+	}
+
 	hashMap.countCommitted = hashMap.Count
 
 	if hashMap.Tx.IsWritable() {
@@ -489,6 +563,7 @@ func CreateNewHashMap[T HashMapElementSerializableInterface](tx store_db_interfa
 		nil,
 		nil,
 		indexable,
+		false,
 	}
 
 	//safe to Get because data will be converted into an integer
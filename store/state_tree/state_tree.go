@@ -0,0 +1,347 @@
+package state_tree
+
+import (
+	"bytes"
+	"errors"
+	"pandora-pay/cryptography"
+	"pandora-pay/helpers"
+	"pandora-pay/store/store_db/store_db_interface"
+	"strconv"
+)
+
+/**
+Authenticated State Tree
+Binary Patricia Merkle trie over the 256 bit leaf keys.
+A subtree with a single leaf is collapsed into the leaf node, so the depth of a leaf is the length of the shortest prefix that distinguishes it from the others and the proofs have O(log N) siblings.
+The root only depends on the current set of leaves, so applying the rollback transitions restores the previous root.
+*/
+
+const KEY_BITS = cryptography.HashSize * 8
+
+const (
+	nodeLeaf     byte = 0
+	nodeInternal byte = 1
+)
+
+var emptyHash = make([]byte, cryptography.HashSize)
+
+type StateTree struct {
+	Tx store_db_interface.StoreDBTransactionInterface
+}
+
+// StateProof contains the siblings from the root down to the leaf position. Leaf is leafKey + leafHash of a different leaf found at the position of a missing key
+type StateProof struct {
+	Siblings [][]byte `json:"siblings" msgpack:"siblings"`
+	Leaf     []byte   `json:"leaf,omitempty" msgpack:"leaf,omitempty"`
+}
+
+type stateNode struct {
+	leaf  bool
+	left  []byte //leafKey for leaves
+	right []byte //leafHash for leaves
+}
+
+func (node *stateNode) hash() []byte {
+	if node.leaf {
+		return hashLeafNode(node.left, node.right)
+	}
+	return hashInternalNode(node.left, node.right)
+}
+
+func hashLeafNode(leafKey, leafHash []byte) []byte {
+	data := make([]byte, 0, 1+len(leafKey)+len(leafHash))
+	data = append(data, nodeLeaf)
+	data = append(data, leafKey...)
+	data = append(data, leafHash...)
+	return cryptography.SHA3(data)
+}
+
+func hashInternalNode(left, right []byte) []byte {
+	if bytes.Equal(left, emptyHash) && bytes.Equal(right, emptyHash) {
+		return emptyHash
+	}
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, nodeInternal)
+	data = append(data, left...)
+	data = append(data, right...)
+	return cryptography.SHA3(data)
+}
+
+func hashNode(left, right []byte) []byte {
+	data := make([]byte, 0, len(left)+len(right))
+	data = append(data, left...)
+	data = append(data, right...)
+	return cryptography.SHA3(data)
+}
+
+func getBit(leafKey []byte, depth int) byte {
+	return (leafKey[depth/8] >> (7 - depth%8)) & 1
+}
+
+func hasSamePrefix(a, b []byte, depth int) bool {
+	for i := 0; i < depth; i++ {
+		if getBit(a, i) != getBit(b, i) {
+			return false
+		}
+	}
+	return true
+}
+
+func nodeKey(depth int, leafKey []byte) string {
+	prefix := helpers.CloneBytes(leafKey[:(depth+7)/8])
+	if depth%8 != 0 {
+		prefix[len(prefix)-1] &= byte(0xff << (8 - depth%8))
+	}
+	return "stateTree:node:" + strconv.Itoa(depth) + ":" + string(prefix)
+}
+
+func LeafKey(name, key string) []byte {
+	return cryptography.SHA3([]byte(name + ":" + key))
+}
+
+func LeafHash(leafKey, value []byte) []byte {
+	return hashNode(leafKey, cryptography.SHA3(value))
+}
+
+func (tree *StateTree) getNode(depth int, leafKey []byte) *stateNode {
+	data := tree.Tx.Get(nodeKey(depth, leafKey))
+	if data == nil {
+		return nil
+	}
+	return &stateNode{
+		data[0] == nodeLeaf,
+		helpers.CloneBytes(data[1 : 1+cryptography.HashSize]),
+		helpers.CloneBytes(data[1+cryptography.HashSize:]),
+	}
+}
+
+func (tree *StateTree) setNode(depth int, leafKey []byte, node *stateNode) {
+	data := make([]byte, 0, 1+2*cryptography.HashSize)
+	if node.leaf {
+		data = append(data, nodeLeaf)
+	} else {
+		data = append(data, nodeInternal)
+	}
+	data = append(data, node.left...)
+	data = append(data, node.right...)
+	tree.Tx.Put(nodeKey(depth, leafKey), data)
+}
+
+func (tree *StateTree) deleteNode(depth int, leafKey []byte) {
+	tree.Tx.Delete(nodeKey(depth, leafKey))
+}
+
+func (tree *StateTree) Root() []byte {
+	if node := tree.getNode(0, emptyHash); node != nil {
+		return node.hash()
+	}
+	return emptyHash
+}
+
+// insert returns the new hash of the subtree at depth
+func (tree *StateTree) insert(depth int, leafKey, leafHash []byte) ([]byte, error) {
+
+	node := tree.getNode(depth, leafKey)
+
+	if node == nil || (node.leaf && bytes.Equal(node.left, leafKey)) {
+		node = &stateNode{true, leafKey, leafHash}
+		tree.setNode(depth, leafKey, node)
+		return node.hash(), nil
+	}
+
+	if depth == KEY_BITS {
+		return nil, errors.New("State Tree is corrupted")
+	}
+
+	if node.leaf { //split the leaf into an internal node
+		other := node
+		node = &stateNode{false, emptyHash, emptyHash}
+		otherHash, err := tree.insert(depth+1, other.left, other.right)
+		if err != nil {
+			return nil, err
+		}
+		if getBit(other.left, depth) == 0 {
+			node.left = otherHash
+		} else {
+			node.right = otherHash
+		}
+	}
+
+	childHash, err := tree.insert(depth+1, leafKey, leafHash)
+	if err != nil {
+		return nil, err
+	}
+	if getBit(leafKey, depth) == 0 {
+		node.left = childHash
+	} else {
+		node.right = childHash
+	}
+
+	tree.setNode(depth, leafKey, node)
+	return node.hash(), nil
+}
+
+// remove returns the new hash of the subtree at depth. An internal node left with a single leaf is collapsed into the leaf
+func (tree *StateTree) remove(depth int, leafKey []byte) ([]byte, error) {
+
+	node := tree.getNode(depth, leafKey)
+	if node == nil {
+		return emptyHash, nil
+	}
+
+	if node.leaf {
+		if bytes.Equal(node.left, leafKey) {
+			tree.deleteNode(depth, leafKey)
+			return emptyHash, nil
+		}
+		return node.hash(), nil
+	}
+
+	childHash, err := tree.remove(depth+1, leafKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var otherHash []byte
+	if getBit(leafKey, depth) == 0 {
+		node.left = childHash
+		otherHash = node.right
+	} else {
+		node.right = childHash
+		otherHash = node.left
+	}
+
+	//the remaining child is a single leaf
+	if bytes.Equal(childHash, emptyHash) || bytes.Equal(otherHash, emptyHash) {
+
+		remaining := helpers.CloneBytes(leafKey)
+		if bytes.Equal(childHash, emptyHash) {
+			remaining[depth/8] ^= 1 << (7 - depth%8)
+		}
+
+		child := tree.getNode(depth+1, remaining)
+		if child == nil {
+			return nil, errors.New("State Tree is corrupted")
+		}
+		if child.leaf {
+			tree.deleteNode(depth+1, remaining)
+			tree.setNode(depth, leafKey, child)
+			return child.hash(), nil
+		}
+	}
+
+	tree.setNode(depth, leafKey, node)
+	return node.hash(), nil
+}
+
+// Update sets the leaves into the tree. A nil leafHash removes the leaf
+func (tree *StateTree) Update(leaves map[string][]byte) (err error) {
+
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	if !tree.Tx.IsWritable() {
+		return errors.New("State Tree can be updated only by a writable transaction")
+	}
+
+	for leafKey, leafHash := range leaves {
+		if len(leafKey) != cryptography.HashSize || (leafHash != nil && len(leafHash) != cryptography.HashSize) {
+			return errors.New("Invalid State Tree leaf")
+		}
+	}
+
+	for leafKey, leafHash := range leaves {
+		if leafHash == nil {
+			_, err = tree.remove(0, []byte(leafKey))
+		} else {
+			_, err = tree.insert(0, []byte(leafKey), leafHash)
+		}
+		if err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// Proof returns the siblings on the path of the leaf key
+func (tree *StateTree) Proof(leafKey []byte) (*StateProof, error) {
+
+	if len(leafKey) != cryptography.HashSize {
+		return nil, errors.New("Invalid State Tree leaf key")
+	}
+
+	proof := &StateProof{
+		Siblings: make([][]byte, 0),
+	}
+
+	for depth := 0; depth <= KEY_BITS; depth++ {
+
+		node := tree.getNode(depth, leafKey)
+		if node == nil {
+			return proof, nil
+		}
+
+		if node.leaf {
+			if !bytes.Equal(node.left, leafKey) {
+				proof.Leaf = append(node.left, node.right...)
+			}
+			return proof, nil
+		}
+
+		if getBit(leafKey, depth) == 0 {
+			proof.Siblings = append(proof.Siblings, node.right)
+		} else {
+			proof.Siblings = append(proof.Siblings, node.left)
+		}
+	}
+
+	return nil, errors.New("State Tree is corrupted")
+}
+
+// VerifyStateProof verifies the inclusion of leafHash. A nil leafHash verifies that the leaf doesn't exist
+func VerifyStateProof(root, leafKey, leafHash []byte, proof *StateProof) bool {
+
+	if proof == nil || len(leafKey) != cryptography.HashSize || len(proof.Siblings) > KEY_BITS {
+		return false
+	}
+
+	depth := len(proof.Siblings)
+
+	var hash []byte
+	if leafHash != nil {
+		if len(leafHash) != cryptography.HashSize || proof.Leaf != nil {
+			return false
+		}
+		hash = hashLeafNode(leafKey, leafHash)
+	} else if proof.Leaf != nil {
+		if len(proof.Leaf) != 2*cryptography.HashSize {
+			return false
+		}
+		otherKey := proof.Leaf[:cryptography.HashSize]
+		if bytes.Equal(otherKey, leafKey) || !hasSamePrefix(otherKey, leafKey, depth) {
+			return false
+		}
+		hash = hashLeafNode(otherKey, proof.Leaf[cryptography.HashSize:])
+	} else {
+		hash = emptyHash
+	}
+
+	for d := depth - 1; d >= 0; d-- {
+		if len(proof.Siblings[d]) != cryptography.HashSize {
+			return false
+		}
+		if getBit(leafKey, d) == 0 {
+			hash = hashInternalNode(hash, proof.Siblings[d])
+		} else {
+			hash = hashInternalNode(proof.Siblings[d], hash)
+		}
+	}
+
+	return bytes.Equal(hash, root)
+}
+
+func NewStateTree(tx store_db_interface.StoreDBTransactionInterface) *StateTree {
+	return &StateTree{tx}
+}
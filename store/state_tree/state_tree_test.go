@@ -0,0 +1,121 @@
+package state_tree
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pandora-pay/cryptography"
+	"pandora-pay/store/store_db/store_db_interface"
+	"pandora-pay/store/store_db/store_db_memory"
+	"testing"
+)
+
+func TestStateTree(t *testing.T) {
+
+	db, err := store_db_memory.CreateStoreDBMemory("test")
+	assert.NoError(t, err)
+
+	leafKeys := make([][]byte, 50)
+	leafHashes := make([][]byte, len(leafKeys))
+	for i := range leafKeys {
+		leafKeys[i] = LeafKey("test", string(cryptography.RandomHash()))
+		leafHashes[i] = LeafHash(leafKeys[i], cryptography.RandomHash())
+	}
+
+	err = db.Update(func(tx store_db_interface.StoreDBTransactionInterface) error {
+
+		tree := NewStateTree(tx)
+		emptyRoot := tree.Root()
+
+		leaves := make(map[string][]byte)
+		for i := 0; i < 25; i++ {
+			leaves[string(leafKeys[i])] = leafHashes[i]
+		}
+		assert.NoError(t, tree.Update(leaves))
+		root := tree.Root()
+		assert.NotEqual(t, emptyRoot, root)
+
+		for i := range leafKeys {
+			proof, err := tree.Proof(leafKeys[i])
+			assert.NoError(t, err)
+			assert.Less(t, len(proof.Siblings), 32)
+			if i < 25 {
+				assert.True(t, VerifyStateProof(root, leafKeys[i], leafHashes[i], proof), "State Proof is invalid")
+				assert.False(t, VerifyStateProof(root, leafKeys[i], nil, proof), "State Proof should be invalid")
+			} else {
+				assert.True(t, VerifyStateProof(root, leafKeys[i], nil, proof), "State Proof is invalid")
+				assert.False(t, VerifyStateProof(root, leafKeys[i], leafHashes[i], proof), "State Proof should be invalid")
+			}
+		}
+
+		leaves = make(map[string][]byte)
+		for i := 25; i < len(leafKeys); i++ {
+			leaves[string(leafKeys[i])] = leafHashes[i]
+		}
+		assert.NoError(t, tree.Update(leaves))
+		assert.NotEqual(t, root, tree.Root())
+
+		//removing the leaves restores the previous root
+		for k := range leaves {
+			leaves[k] = nil
+		}
+		assert.NoError(t, tree.Update(leaves))
+		assert.Equal(t, root, tree.Root())
+
+		leaves = make(map[string][]byte)
+		for i := 0; i < 25; i++ {
+			leaves[string(leafKeys[i])] = nil
+		}
+		assert.NoError(t, tree.Update(leaves))
+		assert.Equal(t, emptyRoot, tree.Root())
+
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestStateTree_SharedPrefix(t *testing.T) {
+
+	db, err := store_db_memory.CreateStoreDBMemory("test")
+	assert.NoError(t, err)
+
+	//the keys differ only in the last bits
+	leafKeys := make([][]byte, 3)
+	for i := range leafKeys {
+		leafKeys[i] = make([]byte, cryptography.HashSize)
+		leafKeys[i][cryptography.HashSize-1] = byte(i)
+	}
+	leafHash := cryptography.RandomHash()
+
+	err = db.Update(func(tx store_db_interface.StoreDBTransactionInterface) error {
+
+		tree := NewStateTree(tx)
+
+		assert.NoError(t, tree.Update(map[string][]byte{string(leafKeys[0]): leafHash}))
+		single := tree.Root()
+
+		assert.NoError(t, tree.Update(map[string][]byte{string(leafKeys[1]): leafHash, string(leafKeys[2]): leafHash}))
+		root := tree.Root()
+
+		for i := range leafKeys {
+			proof, err := tree.Proof(leafKeys[i])
+			assert.NoError(t, err)
+			assert.True(t, VerifyStateProof(root, leafKeys[i], leafHash, proof), "State Proof is invalid")
+		}
+
+		missing := make([]byte, cryptography.HashSize)
+		missing[cryptography.HashSize-1] = 3
+		proof, err := tree.Proof(missing)
+		assert.NoError(t, err)
+		assert.True(t, VerifyStateProof(root, missing, nil, proof), "State Proof is invalid")
+
+		//the internal nodes are collapsed back into the leaf
+		assert.NoError(t, tree.Update(map[string][]byte{string(leafKeys[1]): nil, string(leafKeys[2]): nil}))
+		assert.Equal(t, single, tree.Root())
+
+		proof, err = tree.Proof(leafKeys[0])
+		assert.NoError(t, err)
+		assert.Empty(t, proof.Siblings)
+
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package store_db_bolt
 
 import (
+	"bytes"
 	bolt "go.etcd.io/bbolt"
 	"pandora-pay/helpers"
 	"pandora-pay/store/store_db/store_db_interface"
@@ -36,3 +37,13 @@ func (tx *StoreDBBoltTransaction) Exists(key string) bool {
 func (tx *StoreDBBoltTransaction) Delete(key string) {
 	tx.bucket.Delete([]byte(key))
 }
+
+// keys and values are valid only during the transaction, so they need to be cloned
+func (tx *StoreDBBoltTransaction) Range(prefix string, callback func(key string, value []byte) bool) {
+	c := tx.bucket.Cursor()
+	for k, v := c.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, v = c.Next() {
+		if !callback(string(k), helpers.CloneBytes(v)) {
+			return
+		}
+	}
+}
@@ -3,6 +3,7 @@ package store_db_bunt
 import (
 	buntdb "github.com/tidwall/buntdb"
 	"pandora-pay/store/store_db/store_db_interface"
+	"strings"
 )
 
 type StoreDBBuntTransaction struct {
@@ -39,6 +40,18 @@ func (tx *StoreDBBuntTransaction) Exists(key string) bool {
 	return false
 }
 
+func (tx *StoreDBBuntTransaction) Range(prefix string, callback func(key string, value []byte) bool) {
+	//value is cloned
+	if err := tx.buntTx.AscendGreaterOrEqual("", prefix, func(key, value string) bool {
+		if !strings.HasPrefix(key, prefix) {
+			return false
+		}
+		return callback(key, []byte(value))
+	}); err != nil {
+		panic(err)
+	}
+}
+
 func (tx *StoreDBBuntTransaction) Delete(key string) {
 	_, err := tx.buntTx.Delete(key)
 	if err != buntdb.ErrNotFound {
@@ -5,5 +5,6 @@ type StoreDBTransactionInterface interface {
 	Get(key string) []byte
 	Exists(key string) bool
 	Delete(key string)
+	Range(prefix string, callback func(key string, value []byte) bool) //iterates in ascending key order, return false to stop
 	IsWritable() bool
 }
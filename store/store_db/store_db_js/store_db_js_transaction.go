@@ -6,6 +6,8 @@ import (
 	"pandora-pay/helpers"
 	"pandora-pay/helpers/generics"
 	"pandora-pay/store/store_db/store_db_interface"
+	"sort"
+	"strings"
 	"syscall/js"
 )
 
@@ -88,6 +90,68 @@ func (tx *StoreDBJSTransaction) Delete(key string) {
 	tx.local.Store(key, &StoreDBJSTransactionData{nil, "del"})
 }
 
+func (tx *StoreDBJSTransaction) Range(prefix string, callback func(key string, value []byte) bool) {
+
+	respCh := make(chan []string)
+	defer close(respCh)
+
+	errCh := make(chan error)
+	defer close(errCh)
+
+	promise := tx.jsStore.Call("keys")
+
+	promise.Call("then", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		var result []string
+		if !args[0].IsNull() && !args[0].IsUndefined() {
+			result = make([]string, args[0].Get("length").Int())
+			for i := range result {
+				result[i] = args[0].Index(i).String()
+			}
+		}
+		respCh <- result
+		return nil
+	}), js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		errCh <- fmt.Errorf("error reading keys js db %s", args[0].Get("message").String())
+		return nil
+	}))
+
+	var storedKeys []string
+	select {
+	case storedKeys = <-respCh:
+	case <-errCh:
+		return
+	}
+
+	keysMap := make(map[string]bool)
+	for _, key := range storedKeys {
+		if strings.HasPrefix(key, prefix) {
+			keysMap[key] = true
+		}
+	}
+
+	//local changes are not yet written in the store
+	tx.local.Range(func(key string, data *StoreDBJSTransactionData) bool {
+		if strings.HasPrefix(key, prefix) {
+			keysMap[key] = true
+		}
+		return true
+	})
+
+	keys := make([]string, 0, len(keysMap))
+	for key := range keysMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value := tx.Get(key); value != nil {
+			if !callback(key, value) {
+				return
+			}
+		}
+	}
+}
+
 func (tx *StoreDBJSTransaction) writeTx() error {
 
 	if !tx.write {
@@ -0,0 +1,85 @@
+package store_db_leveldb
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"os"
+	"pandora-pay/helpers/generics"
+	"pandora-pay/store/store_db/store_db_interface"
+	"sync"
+)
+
+const dbName = "leveldb"
+
+type StoreDBLevelDB struct {
+	store_db_interface.StoreDBInterface
+	DB    *leveldb.DB
+	Name  []byte
+	mutex *sync.Mutex //leveldb allows concurrent writes, but Update must be serialized
+}
+
+func (store *StoreDBLevelDB) Close() error {
+	return store.DB.Close()
+}
+
+func (store *StoreDBLevelDB) View(callback func(dbTx store_db_interface.StoreDBTransactionInterface) error) error {
+
+	snapshot, err := store.DB.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+
+	tx := &StoreDBLevelDBTransaction{
+		snapshot: snapshot,
+		local:    &generics.Map[string, *StoreDBLevelDBTransactionData]{},
+	}
+	return callback(tx)
+}
+
+func (store *StoreDBLevelDB) Update(callback func(dbTx store_db_interface.StoreDBTransactionInterface) error) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	snapshot, err := store.DB.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+
+	tx := &StoreDBLevelDBTransaction{
+		snapshot: snapshot,
+		local:    &generics.Map[string, *StoreDBLevelDBTransactionData]{},
+		write:    true,
+	}
+
+	if err = callback(tx); err != nil {
+		return err
+	}
+
+	return store.DB.Write(tx.batch(), nil)
+}
+
+func CreateStoreDBLevelDB(name string) (*StoreDBLevelDB, error) {
+
+	var err error
+
+	store := &StoreDBLevelDB{
+		Name:  []byte(name),
+		mutex: &sync.Mutex{},
+	}
+
+	prefix := "./store"
+	if _, err = os.Stat(prefix); os.IsNotExist(err) {
+		if err = os.Mkdir(prefix, 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	// Open the store directory in your current directory.
+	// It will be created if it doesn't exist.
+	if store.DB, err = leveldb.OpenFile(prefix+name+"_store"+"."+dbName, nil); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
@@ -0,0 +1,127 @@
+package store_db_leveldb
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"pandora-pay/helpers"
+	"pandora-pay/helpers/generics"
+	"pandora-pay/store/store_db/store_db_interface"
+	"sort"
+	"strings"
+)
+
+type StoreDBLevelDBTransactionData struct {
+	value     []byte
+	operation string
+}
+
+type StoreDBLevelDBTransaction struct {
+	store_db_interface.StoreDBTransactionInterface
+	snapshot *leveldb.Snapshot
+	write    bool
+	local    *generics.Map[string, *StoreDBLevelDBTransactionData]
+}
+
+func (tx *StoreDBLevelDBTransaction) IsWritable() bool {
+	return tx.write
+}
+
+func (tx *StoreDBLevelDBTransaction) Put(key string, value []byte) {
+	if !tx.write {
+		panic("Transaction is not writeable")
+	}
+	tx.local.Store(key, &StoreDBLevelDBTransactionData{helpers.CloneBytes(value), "put"})
+}
+
+func (tx *StoreDBLevelDBTransaction) Get(key string) []byte {
+
+	data, ok := tx.local.Load(key)
+	if ok {
+		if data.operation == "del" {
+			return nil
+		}
+		return helpers.CloneBytes(data.value)
+	}
+
+	//leveldb returns a copy
+	resp, err := tx.snapshot.Get([]byte(key), nil)
+	if err != nil {
+		if err != leveldb.ErrNotFound {
+			panic(err)
+		}
+		resp = nil
+	}
+
+	tx.local.Store(key, &StoreDBLevelDBTransactionData{resp, "get"})
+	return helpers.CloneBytes(resp)
+}
+
+func (tx *StoreDBLevelDBTransaction) Exists(key string) bool {
+	data := tx.Get(key)
+	if data != nil {
+		return true
+	}
+	return false
+}
+
+func (tx *StoreDBLevelDBTransaction) Delete(key string) {
+	if !tx.write {
+		panic("Transaction is not writeable")
+	}
+	tx.local.Store(key, &StoreDBLevelDBTransactionData{nil, "del"})
+}
+
+func (tx *StoreDBLevelDBTransaction) Range(prefix string, callback func(key string, value []byte) bool) {
+
+	values := make(map[string][]byte)
+
+	it := tx.snapshot.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+	for it.Next() {
+		//iterator keys and values are reused
+		values[string(it.Key())] = helpers.CloneBytes(it.Value())
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		panic(err)
+	}
+
+	//local changes are not yet written in the store
+	tx.local.Range(func(key string, data *StoreDBLevelDBTransactionData) bool {
+		if strings.HasPrefix(key, prefix) {
+			if data.operation == "del" {
+				delete(values, key)
+			} else if data.operation == "put" {
+				values[key] = data.value
+			}
+		}
+		return true
+	})
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !callback(key, helpers.CloneBytes(values[key])) {
+			return
+		}
+	}
+}
+
+func (tx *StoreDBLevelDBTransaction) batch() *leveldb.Batch {
+
+	batch := new(leveldb.Batch)
+
+	tx.local.Range(func(key string, data *StoreDBLevelDBTransactionData) bool {
+		if data.operation == "del" {
+			batch.Delete([]byte(key))
+		} else if data.operation == "put" {
+			batch.Put([]byte(key), data.value)
+		}
+		return true
+	})
+
+	return batch
+}
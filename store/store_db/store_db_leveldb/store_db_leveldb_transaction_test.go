@@ -0,0 +1,66 @@
+package store_db_leveldb
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"pandora-pay/store/store_db/store_db_interface"
+	"testing"
+)
+
+func TestStoreDBLevelDBTransaction_Range(t *testing.T) {
+
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(t.TempDir()))
+	defer os.Chdir(wd)
+
+	db, err := CreateStoreDBLevelDB("/test")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	err = db.Update(func(tx store_db_interface.StoreDBTransactionInterface) error {
+		tx.Put("a:2", []byte{2})
+		tx.Put("a:1", []byte{1})
+		tx.Put("a:3", []byte{3})
+		tx.Put("b:1", []byte{4})
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = db.Update(func(tx store_db_interface.StoreDBTransactionInterface) error {
+
+		tx.Delete("a:2")
+		tx.Put("a:0", []byte{0})
+
+		keys := []string{}
+		values := []byte{}
+		tx.Range("a:", func(key string, value []byte) bool {
+			keys = append(keys, key)
+			values = append(values, value...)
+			return true
+		})
+		assert.Equal(t, []string{"a:0", "a:1", "a:3"}, keys)
+		assert.Equal(t, []byte{0, 1, 3}, values)
+
+		count := 0
+		tx.Range("a:", func(key string, value []byte) bool {
+			count += 1
+			return false
+		})
+		assert.Equal(t, 1, count)
+
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = db.View(func(tx store_db_interface.StoreDBTransactionInterface) error {
+		keys := []string{}
+		tx.Range("a:", func(key string, value []byte) bool {
+			keys = append(keys, key)
+			return true
+		})
+		assert.Equal(t, []string{"a:0", "a:1", "a:3"}, keys)
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -5,6 +5,8 @@ import (
 	"pandora-pay/helpers"
 	"pandora-pay/helpers/generics"
 	"pandora-pay/store/store_db/store_db_interface"
+	"sort"
+	"strings"
 )
 
 type StoreDBMemoryTransactionData struct {
@@ -60,6 +62,38 @@ func (tx *StoreDBMemoryTransaction) Delete(key string) {
 	tx.local.Store(key, &StoreDBMemoryTransactionData{nil, "del"})
 }
 
+func (tx *StoreDBMemoryTransaction) Range(prefix string, callback func(key string, value []byte) bool) {
+
+	keysMap := make(map[string]bool)
+	for key := range tx.store {
+		if strings.HasPrefix(key, prefix) {
+			keysMap[key] = true
+		}
+	}
+
+	//local changes are not yet written in the store
+	tx.local.Range(func(key string, data *StoreDBMemoryTransactionData) bool {
+		if strings.HasPrefix(key, prefix) {
+			keysMap[key] = true
+		}
+		return true
+	})
+
+	keys := make([]string, 0, len(keysMap))
+	for key := range keysMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value := tx.Get(key); value != nil {
+			if !callback(key, value) {
+				return
+			}
+		}
+	}
+}
+
 func (tx *StoreDBMemoryTransaction) writeTx() error {
 
 	if !tx.write {
@@ -0,0 +1,48 @@
+package store_db_memory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pandora-pay/store/store_db/store_db_interface"
+	"testing"
+)
+
+func TestStoreDBMemoryTransaction_Range(t *testing.T) {
+
+	db, err := CreateStoreDBMemory("test")
+	assert.NoError(t, err)
+
+	err = db.Update(func(tx store_db_interface.StoreDBTransactionInterface) error {
+		tx.Put("a:2", []byte{2})
+		tx.Put("a:1", []byte{1})
+		tx.Put("a:3", []byte{3})
+		tx.Put("b:1", []byte{4})
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = db.Update(func(tx store_db_interface.StoreDBTransactionInterface) error {
+
+		tx.Delete("a:2")
+		tx.Put("a:0", []byte{0})
+
+		keys := []string{}
+		values := []byte{}
+		tx.Range("a:", func(key string, value []byte) bool {
+			keys = append(keys, key)
+			values = append(values, value...)
+			return true
+		})
+		assert.Equal(t, []string{"a:0", "a:1", "a:3"}, keys)
+		assert.Equal(t, []byte{0, 1, 3}, values)
+
+		count := 0
+		tx.Range("a:", func(key string, value []byte) bool {
+			count += 1
+			return false
+		})
+		assert.Equal(t, 1, count)
+
+		return nil
+	})
+	assert.NoError(t, err)
+}
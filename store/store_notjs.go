@@ -6,12 +6,16 @@ package store
 import (
 	"errors"
 	"pandora-pay/config/arguments"
+	"pandora-pay/gui"
 	"pandora-pay/store/store_db/store_db_bolt"
 	"pandora-pay/store/store_db/store_db_bunt"
 	"pandora-pay/store/store_db/store_db_interface"
+	"pandora-pay/store/store_db/store_db_leveldb"
 	"pandora-pay/store/store_db/store_db_memory"
 )
 
+const migrateBatchSize = 10000
+
 func createStoreNow(name, storeType string) (*Store, error) {
 
 	var db store_db_interface.StoreDBInterface
@@ -26,6 +30,8 @@ func createStoreNow(name, storeType string) (*Store, error) {
 		db, err = store_db_bunt.CreateStoreDBBunt(name, true)
 	case "memory":
 		db, err = store_db_memory.CreateStoreDBMemory(name)
+	case "leveldb":
+		db, err = store_db_leveldb.CreateStoreDBLevelDB(name)
 	default:
 		err = errors.New("Invalid --store-type argument")
 	}
@@ -42,15 +48,93 @@ func createStoreNow(name, storeType string) (*Store, error) {
 	return store, nil
 }
 
+// copies all the keys of an existing store into a new and empty store
+func migrateStoreNow(name, fromType string, to *Store) (err error) {
+
+	from, err := createStoreNow(name, fromType)
+	if err != nil {
+		return
+	}
+	defer from.close()
+
+	empty := true
+	if err = to.DB.View(func(dbTx store_db_interface.StoreDBTransactionInterface) error {
+		dbTx.Range("", func(key string, value []byte) bool {
+			empty = false
+			return false
+		})
+		return nil
+	}); err != nil {
+		return
+	}
+	if !empty {
+		return errors.New("Store to migrate into is not empty")
+	}
+
+	gui.GUI.Info("Migrating store", name, "from", fromType)
+
+	keys := make([]string, 0, migrateBatchSize)
+	values := make([][]byte, 0, migrateBatchSize)
+	count := 0
+
+	flush := func() (err error) {
+		if len(keys) == 0 {
+			return
+		}
+		err = to.DB.Update(func(dbTx store_db_interface.StoreDBTransactionInterface) error {
+			for i := range keys {
+				dbTx.Put(keys[i], values[i])
+			}
+			return nil
+		})
+		keys = keys[:0]
+		values = values[:0]
+		return
+	}
+
+	if err = from.DB.View(func(dbTx store_db_interface.StoreDBTransactionInterface) (err error) {
+		dbTx.Range("", func(key string, value []byte) bool {
+			keys = append(keys, key)
+			values = append(values, value)
+			count += 1
+			if len(keys) == migrateBatchSize {
+				if err = flush(); err != nil {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return
+		}
+		return flush()
+	}); err != nil {
+		return
+	}
+
+	gui.GUI.Info("Migrating store finished", name, count, "keys")
+	return
+}
+
 func create_db() (err error) {
 
 	var prefix = ""
 
-	allowedStores := map[string]bool{"bolt": true, "bunt": true, "bunt-memory": true, "memory": true}
+	allowedStores := map[string]bool{"bolt": true, "bunt": true, "bunt-memory": true, "memory": true, "leveldb": true}
 
-	if StoreBlockchain, err = createStoreNow(prefix+"/blockchain", getStoreType(arguments.Arguments["--store-chain-type"].(string), allowedStores)); err != nil {
+	chainType := getStoreType(arguments.Arguments["--store-chain-type"].(string), allowedStores)
+	if StoreBlockchain, err = createStoreNow(prefix+"/blockchain", chainType); err != nil {
 		return
 	}
+	if arguments.Arguments["--store-chain-migrate"] != nil {
+		migrateType := getStoreType(arguments.Arguments["--store-chain-migrate"].(string), allowedStores)
+		if migrateType == "" || migrateType == "memory" || migrateType == "bunt-memory" || migrateType == chainType {
+			return errors.New("Invalid --store-chain-migrate argument")
+		}
+		if err = migrateStoreNow(prefix+"/blockchain", migrateType, StoreBlockchain); err != nil {
+			return
+		}
+	}
 	if StoreWallet, err = createStoreNow(prefix+"/wallet", getStoreType(arguments.Arguments["--store-wallet-type"].(string), allowedStores)); err != nil {
 		return
 	}
@@ -8,6 +8,7 @@ import (
 	"pandora-pay/blockchain/data_storage/plain_accounts"
 	"pandora-pay/blockchain/data_storage/plain_accounts/plain_account"
 	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/blockchain/transactions/transaction/transaction_type"
 	"pandora-pay/mempool"
 	"pandora-pay/network/websocks/connection/advanced_connection_types"
 	"pandora-pay/store"
@@ -33,6 +34,23 @@ func (builder *TxsBuilderType) getNonce(nonce uint64, publicKey []byte, accNonce
 	return builder.mempool.GetNonce(publicKey, accNonce)
 }
 
+// setAutoFee uses the medium fee estimated by the mempool when the fee per byte is computed automatically
+func (builder *TxsBuilderType) setAutoFee(fee *wizard.WizardTransactionFee, version transaction_type.TransactionVersion) {
+
+	if fee.Fixed > 0 || fee.PerByte > 0 || !fee.PerByteAuto {
+		return
+	}
+
+	estimates := builder.mempool.GetFeeEstimates()
+	switch version {
+	case transaction_type.TX_SIMPLE:
+		fee.PerByte = estimates.Simple.Medium
+	case transaction_type.TX_ZETHER:
+		fee.PerByte = estimates.Zether.Medium
+	}
+	fee.PerByteExtraSpace = estimates.PerByteExtraSpace
+}
+
 func (builder *TxsBuilderType) convertFloatAmounts(amounts []float64, ast *asset.Asset) ([]uint64, error) {
 
 	var err error
@@ -59,8 +77,8 @@ func (builder *TxsBuilderType) getWalletAddresses(senders []string) ([]*wallet_a
 		if sendersWalletAddress[i], err = builder.wallet.GetWalletAddressByEncodedAddress(senderAddress, true); err != nil {
 			return nil, err
 		}
-		if sendersWalletAddress[i].PrivateKey == nil {
-			return nil, fmt.Errorf("Can't be used for transactions as the private key is missing for sender %s", senderAddress)
+		if err = sendersWalletAddress[i].CheckCanSign(); err != nil {
+			return nil, fmt.Errorf("Can't be used for transactions for sender %s: %s", senderAddress, err.Error())
 		}
 	}
 
@@ -75,6 +93,7 @@ func (builder *TxsBuilderType) CreateSimpleTx(txData *TxBuilderCreateSimpleTx, p
 	if txData.Fee == nil {
 		txData.Fee = &wizard.WizardTransactionFee{0, 0, 0, true}
 	}
+	builder.setAutoFee(txData.Fee, transaction_type.TX_SIMPLE)
 
 	var sendersWalletAddresses []*wallet_address.WalletAddress
 	var err error
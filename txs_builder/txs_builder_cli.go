@@ -4,26 +4,37 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"pandora-pay/addresses"
+	"pandora-pay/blockchain/data_storage/accounts"
+	"pandora-pay/blockchain/data_storage/accounts/account"
 	"pandora-pay/blockchain/data_storage/assets"
 	"pandora-pay/blockchain/data_storage/assets/asset"
 	"pandora-pay/blockchain/data_storage/plain_accounts/plain_account/asset_fee_liquidity"
+	"pandora-pay/blockchain/transactions/transaction"
 	"pandora-pay/blockchain/transactions/transaction/transaction_simple/transaction_simple_extra"
 	"pandora-pay/blockchain/transactions/transaction/transaction_zether"
 	"pandora-pay/blockchain/transactions/transaction/transaction_zether/transaction_zether_payload/transaction_zether_payload_extra"
 	"pandora-pay/config/config_assets"
 	"pandora-pay/config/config_coins"
 	"pandora-pay/cryptography"
+	"pandora-pay/cryptography/crypto"
 	"pandora-pay/gui"
 	"pandora-pay/helpers"
+	"pandora-pay/helpers/advanced_buffers"
 	"pandora-pay/helpers/files"
+	"pandora-pay/network/websocks/connection/advanced_connection_types"
 	"pandora-pay/store"
 	"pandora-pay/store/store_db/store_db_interface"
 	"pandora-pay/txs_builder/wizard"
+	"pandora-pay/txs_validator"
+	"strconv"
+	"strings"
 )
 
 func (builder *TxsBuilderType) showWarningIfNotSyncCLI() {
@@ -66,17 +77,40 @@ func (builder *TxsBuilderType) readAmount(assetId []byte, text string) (amount u
 	return
 }
 
+// decodeAddressOrContact accepts an encoded address or the name of a contact from the address book
+func (builder *TxsBuilderType) decodeAddressOrContact(str string, assetId []byte) (*addresses.Address, error) {
+
+	if address, err := addresses.DecodeAddr(str); err == nil {
+		return address, nil
+	}
+
+	contact := builder.wallet.GetContact(str)
+	if contact == nil {
+		return nil, errors.New("Invalid Address or Contact")
+	}
+
+	if assetId != nil && len(contact.Asset) > 0 && !bytes.Equal(contact.Asset, assetId) {
+		gui.GUI.OutputWrite(fmt.Sprintf("Contact %s uses by default the asset %s", contact.Name, base64.StdEncoding.EncodeToString(contact.Asset)))
+	}
+
+	return contact.GetAddress(assetId)
+}
+
 func (builder *TxsBuilderType) readAddress(text string, leaveEmpty bool) (address *addresses.Address, err error) {
 
+	text2 := text + " or Contact name"
+	if leaveEmpty {
+		text2 = text2 + ". Leave empty for none"
+	}
+
 	for {
-		str := gui.GUI.OutputReadString(text)
+		str := gui.GUI.OutputReadString(text2)
 		if leaveEmpty && len(str) == 0 {
 			break
 		}
 
-		address, err = addresses.DecodeAddr(str)
-		if err != nil {
-			gui.GUI.OutputWrite("Invalid Address")
+		if address, err = builder.decodeAddressOrContact(str, nil); err != nil {
+			gui.GUI.OutputWrite("Invalid Address or Contact")
 			continue
 		}
 		break
@@ -87,9 +121,9 @@ func (builder *TxsBuilderType) readAddress(text string, leaveEmpty bool) (addres
 
 func (builder *TxsBuilderType) readAddressOptional(text string, assetId []byte, allowRandomAddress bool) (address *addresses.Address, addressEncoded string, amount uint64, err error) {
 
-	text2 := text
+	text2 := text + " or Contact name"
 	if allowRandomAddress {
-		text2 = text + ". Leave empty for none"
+		text2 = text2 + ". Leave empty for none"
 	}
 
 	for {
@@ -98,8 +132,8 @@ func (builder *TxsBuilderType) readAddressOptional(text string, assetId []byte,
 			return
 		}
 
-		if address, err = addresses.DecodeAddr(str); err != nil {
-			gui.GUI.OutputWrite("Invalid Address")
+		if address, err = builder.decodeAddressOrContact(str, assetId); err != nil {
+			gui.GUI.OutputWrite("Invalid Address or Contact")
 			continue
 		}
 		break
@@ -221,6 +255,309 @@ func (builder *TxsBuilderType) initCLI() {
 		return
 	}
 
+	cliPrivateTransferOfflineRequest := func(cmd string, ctx context.Context) (err error) {
+		builder.showWarningIfNotSyncCLI()
+
+		txData := &TxBuilderCreateZetherTxData{
+			Payloads: []*TxBuilderCreateZetherTxPayload{{}},
+		}
+
+		if _, txData.Payloads[0].Sender, _, err = builder.wallet.CliSelectAddress("Select Address to Transfer", ctx); err != nil {
+			return
+		}
+
+		txData.Payloads[0].Asset = builder.readAsset("Asset. Leave empty for Native Asset", true)
+
+		if _, txData.Payloads[0].Recipient, txData.Payloads[0].Amount, err = builder.readAddressOptional("Recipient Address", txData.Payloads[0].Asset, false); err != nil {
+			return
+		}
+
+		builder.readZetherRingConfiguration(txData.Payloads[0])
+		txData.Payloads[0].Data = builder.readData()
+		txData.Payloads[0].Fee = builder.readZetherFee(txData.Payloads[0].Asset)
+
+		request, err := builder.CreateZetherTxRequest(txData, nil, ctx, func(status string) {
+			gui.GUI.OutputWrite(status)
+		})
+		if err != nil {
+			return
+		}
+
+		filename := gui.GUI.OutputReadFilename("Path to export the transaction request", "pandoratxrequest", false)
+
+		var marshal []byte
+		if marshal, err = json.Marshal(request); err != nil {
+			return errors.New("Error marshaling transaction request")
+		}
+
+		if err = files.WriteFile(filename, string(marshal)); err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite("Transaction request exported successfully to: ", filename)
+		return
+	}
+
+	cliSignOfflineRequest := func(cmd string, ctx context.Context) (err error) {
+
+		filename := gui.GUI.OutputReadFilename("Path to import the transaction request", "pandoratxrequest", false)
+
+		var file []byte
+		if file, err = os.ReadFile(filename); err != nil {
+			return
+		}
+
+		request := &TxBuilderZetherTxRequest{}
+		if err = json.Unmarshal(file, request); err != nil {
+			return errors.New("Error unmarshaling transaction request")
+		}
+
+		tx, err := builder.SignZetherTxRequest(request, ctx, func(status string) {
+			gui.GUI.OutputWrite(status)
+		})
+		if err != nil {
+			return
+		}
+
+		//the request was created by an online node, so the transfers must be verified before exporting the signature
+		txBase := tx.TransactionBaseInterface.(*transaction_zether.TransactionZether)
+		for t, transfer := range request.Transfers {
+			gui.GUI.OutputWrite(fmt.Sprintf("Transfer %d", t))
+			gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s", "Sender", request.Senders[t]))
+			gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s", "Recipient", transfer.Recipient))
+			gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s", "Asset", base64.StdEncoding.EncodeToString(transfer.Asset)))
+			gui.GUI.OutputWrite(fmt.Sprintf("%18s: %d", "Amount", transfer.Amount))
+			gui.GUI.OutputWrite(fmt.Sprintf("%18s: %d", "Burn", transfer.Burn))
+			gui.GUI.OutputWrite(fmt.Sprintf("%18s: %d", "Fee", txBase.Payloads[t].Statement.Fee))
+		}
+
+		if !gui.GUI.OutputReadBool("Export the signed transaction? y/n. Leave empty for no", true, false) {
+			return errors.New("Signed transaction was not exported")
+		}
+
+		filename = gui.GUI.OutputReadFilename("Path to export the signed transaction", "pandoratx", false)
+
+		if err = files.WriteFile(filename, base64.StdEncoding.EncodeToString(tx.Bloom.Serialized)); err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Tx signed: %s %s", base64.StdEncoding.EncodeToString(tx.Bloom.Hash), cmd))
+		gui.GUI.OutputWrite("Signed transaction exported successfully to: ", filename)
+		return
+	}
+
+	cliBroadcastSignedTx := func(cmd string, ctx context.Context) (err error) {
+
+		filename := gui.GUI.OutputReadFilename("Path to import the signed transaction", "pandoratx", false)
+
+		var file []byte
+		if file, err = os.ReadFile(filename); err != nil {
+			return
+		}
+
+		var serialized []byte
+		if serialized, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(file))); err != nil {
+			return
+		}
+
+		tx := &transaction.Transaction{}
+		if err = tx.Deserialize(advanced_buffers.NewBufferReader(serialized)); err != nil {
+			return
+		}
+		if err = txs_validator.TxsValidator.ValidateTx(tx); err != nil {
+			return
+		}
+
+		var chainHeight uint64
+		if err = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) error {
+			chainHeight, _ = binary.Uvarint(reader.Get("chainHeight"))
+			return nil
+		}); err != nil {
+			return
+		}
+
+		if err = builder.mempool.AddTxToMempool(tx, chainHeight, true, true, true, advanced_connection_types.UUID_ALL, ctx); err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Tx broadcasted: %s %s", base64.StdEncoding.EncodeToString(tx.Bloom.Hash), cmd))
+		return
+	}
+
+	cliPrivateTransferBatch := func(cmd string, ctx context.Context) (err error) {
+		builder.showWarningIfNotSyncCLI()
+
+		data := &TxBuilderBatchData{}
+
+		if _, data.Sender, _, err = builder.wallet.CliSelectAddress("Select Address to Transfer", ctx); err != nil {
+			return
+		}
+
+		filename := gui.GUI.OutputReadFilename("Path to the batch file (CSV recipient,asset,amount,message or JSON)", "csv", false)
+
+		var file []byte
+		if file, err = os.ReadFile(filename); err != nil {
+			return
+		}
+
+		if data.Transfers, err = ParseBatchTransfers(file); err != nil {
+			return
+		}
+
+		data.RingSize = gui.GUI.OutputReadInt("Ring Size (2,4,8,16,32,64,128,256). Leave empty for random", true, -1, func(value int) bool {
+			switch value {
+			case 2, 4, 8, 16, 32, 64, 128, 256:
+				return true
+			default:
+				return false
+			}
+		})
+
+		propagate := gui.GUI.OutputReadBool("Propagate? y/n. Leave empty for yes", true, true)
+
+		results, txs, err := builder.CreateZetherBatchTxs(data, propagate, true, true, ctx, func(status string) {
+			gui.GUI.OutputWrite(status)
+		})
+		if err != nil {
+			return
+		}
+
+		for i, result := range results {
+			if result.Error != "" {
+				gui.GUI.OutputWrite(fmt.Sprintf("%4d %s: error %s", i+1, result.Recipient, result.Error))
+			} else {
+				gui.GUI.OutputWrite(fmt.Sprintf("%4d %s: %s", i+1, result.Recipient, base64.StdEncoding.EncodeToString(result.TxHash)))
+			}
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Txs created: %d %s", len(txs), cmd))
+		return
+	}
+
+	cliPrivateDelegateStake := func(cmd string, ctx context.Context) (err error) {
+		builder.showWarningIfNotSyncCLI()
+
+		txData := &TxBuilderCreateZetherTxData{
+			Payloads: []*TxBuilderCreateZetherTxPayload{{
+				Asset: config_coins.NATIVE_ASSET_FULL,
+			}},
+		}
+
+		if _, txData.Payloads[0].Sender, _, err = builder.wallet.CliSelectAddress("Select Address which will delegate the stake", ctx); err != nil {
+			return
+		}
+
+		var stakedAddress *addresses.Address
+		for {
+			if stakedAddress, err = builder.readAddress("Staked Address (delegator's shared staked address)", false); err != nil {
+				return
+			}
+			if !stakedAddress.Staked {
+				gui.GUI.OutputWrite("Address is not Staked")
+				continue
+			}
+			break
+		}
+		txData.Payloads[0].Recipient = stakedAddress.EncodeAddr()
+
+		if txData.Payloads[0].Amount, err = builder.readAmount(config_coins.NATIVE_ASSET_FULL, "Amount to Delegate"); err != nil {
+			return
+		}
+
+		builder.readZetherRingConfiguration(txData.Payloads[0])
+		txData.Payloads[0].RingConfiguration.SenderRingType.AvoidStakedAccounts = true
+		txData.Payloads[0].RingConfiguration.RecipientRingType.RequireStakedAccounts = true
+		txData.Payloads[0].Data = builder.readData()
+		txData.Payloads[0].Fee = builder.readZetherFee(config_coins.NATIVE_ASSET_FULL)
+		propagate := gui.GUI.OutputReadBool("Propagate? y/n. Leave empty for yes", true, true)
+
+		tx, err := builder.CreateZetherTx(txData, nil, propagate, true, true, false, ctx, func(status string) {
+			gui.GUI.OutputWrite(status)
+		})
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Tx created: %s %s", base64.StdEncoding.EncodeToString(tx.Bloom.Hash), cmd))
+		return
+	}
+
+	cliPrivateClaim := func(cmd string, ctx context.Context) (err error) {
+		builder.showWarningIfNotSyncCLI()
+
+		txData := &TxBuilderCreateZetherTxData{
+			Payloads: []*TxBuilderCreateZetherTxPayload{{
+				Asset: config_coins.NATIVE_ASSET_FULL,
+			}},
+		}
+
+		walletAddress, sender, _, err := builder.wallet.CliSelectAddress("Select Staked Address to Claim from", ctx)
+		if err != nil {
+			return
+		}
+		if !walletAddress.Staked {
+			return errors.New("Address is not Staked")
+		}
+		txData.Payloads[0].Sender = sender
+
+		var balance *crypto.ElGamal
+		if err = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+			var accs *accounts.Accounts
+			if accs, err = accounts.NewAccounts(reader, config_coins.NATIVE_ASSET_FULL); err != nil {
+				return
+			}
+			var acc *account.Account
+			if acc, err = accs.Get(string(walletAddress.PublicKey)); err != nil {
+				return
+			}
+			if acc == nil {
+				return errors.New("Staked Address has no balance")
+			}
+			balance = acc.Balance.Amount
+			return
+		}); err != nil {
+			return
+		}
+
+		staked, err := builder.wallet.DecryptBalanceByPublicKey(walletAddress.PublicKey, balance.Serialize(), config_coins.NATIVE_ASSET_FULL, false, 0, true, true, ctx, func(status string) {
+			gui.GUI.Info2Update("Decrypted", status)
+		})
+		if err != nil {
+			return
+		}
+		gui.GUI.OutputWrite(fmt.Sprintf("Staked balance: %s", strconv.FormatFloat(config_coins.ConvertToBase(staked), 'f', config_coins.DECIMAL_SEPARATOR, 64)))
+
+		if _, txData.Payloads[0].Recipient, _, err = builder.readAddressOptional("Recipient Address", config_coins.NATIVE_ASSET_FULL, true); err != nil {
+			return
+		}
+		if txData.Payloads[0].Recipient == "" {
+			return errors.New("Recipient Address is required")
+		}
+		if txData.Payloads[0].Amount, err = builder.readAmount(config_coins.NATIVE_ASSET_FULL, "Amount to Claim"); err != nil {
+			return
+		}
+		if txData.Payloads[0].Amount > staked {
+			return errors.New("Amount to Claim exceeds the staked balance")
+		}
+
+		builder.readZetherRingConfiguration(txData.Payloads[0])
+		txData.Payloads[0].RingConfiguration.SenderRingType.RequireStakedAccounts = true
+		txData.Payloads[0].RingConfiguration.RecipientRingType.AvoidStakedAccounts = true
+		txData.Payloads[0].Data = builder.readData()
+		txData.Payloads[0].Fee = builder.readZetherFee(config_coins.NATIVE_ASSET_FULL)
+		propagate := gui.GUI.OutputReadBool("Propagate? y/n. Leave empty for yes", true, true)
+
+		tx, err := builder.CreateZetherTx(txData, nil, propagate, true, true, false, ctx, func(status string) {
+			gui.GUI.OutputWrite(status)
+		})
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Tx created: %s %s", base64.StdEncoding.EncodeToString(tx.Bloom.Hash), cmd))
+		return
+	}
+
 	cliPrivateAssetCreate := func(cmd string, ctx context.Context) (err error) {
 		builder.showWarningIfNotSyncCLI()
 
@@ -278,14 +615,14 @@ func (builder *TxsBuilderType) initCLI() {
 		gui.GUI.OutputWrite(fmt.Sprintf("Tx created: %s %s", base64.StdEncoding.EncodeToString(tx.Bloom.Hash), cmd))
 
 		assetId := tx.TransactionBaseInterface.(*transaction_zether.TransactionZether).Payloads[0].Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetCreate).GetAssetId(tx.Bloom.Hash, 0)
-		gui.GUI.OutputWrite(fmt.Sprintf("Asset Id: %s", base64.StdEncoding.EncodeToString(assetId), cmd))
+		gui.GUI.OutputWrite(fmt.Sprintf("Asset Id: %s", base64.StdEncoding.EncodeToString(assetId)))
 
 		if updatePrivKey != nil || supplyPrivKey != nil {
 
 			if filename := gui.GUI.OutputReadFilename("Path to export Asset Private Keys", "keys", true); len(filename) > 0 {
 				if err = files.WriteFile(filename,
 					fmt.Sprintf("Asset ID: %s", base64.StdEncoding.EncodeToString(assetId)),
-					fmt.Sprintf("Asset name: %s", extra.Asset.Name, extra.Asset.Ticker),
+					fmt.Sprintf("Asset name: %s %s", extra.Asset.Name, extra.Asset.Ticker),
 					fmt.Sprintf("Supply Private Key: %s", base64.StdEncoding.EncodeToString(supplyPrivKey.Key)),
 					fmt.Sprintf("Update Private Key: %s", base64.StdEncoding.EncodeToString(updatePrivKey.Key)),
 				); err != nil {
@@ -347,6 +684,173 @@ func (builder *TxsBuilderType) initCLI() {
 		return
 	}
 
+	cliPrivateAssetSupplyDecrease := func(cmd string, ctx context.Context) (err error) {
+		builder.showWarningIfNotSyncCLI()
+
+		extra := &wizard.WizardZetherPayloadExtraAssetSupplyDecrease{}
+		txData := &TxBuilderCreateZetherTxData{
+			Payloads: []*TxBuilderCreateZetherTxPayload{{
+				Extra: extra,
+			}},
+		}
+
+		if _, txData.Payloads[0].Sender, _, err = builder.wallet.CliSelectAddress("Select Address which will burn and decrease the supply of asset", ctx); err != nil {
+			return
+		}
+
+		txData.Payloads[0].Asset = builder.readAsset("Asset", false)
+
+		extra.AssetSupplyPrivateKey = gui.GUI.OutputReadBytes("Asset Supply Update Private Key", func(value []byte) bool {
+			return len(value) == cryptography.PrivateKeySize
+		})
+
+		if txData.Payloads[0].Burn, err = builder.readAmount(txData.Payloads[0].Asset, "Burn Amount"); err != nil {
+			return
+		}
+
+		if _, txData.Payloads[0].Recipient, txData.Payloads[0].Amount, err = builder.readAddressOptional("Transfer Address", txData.Payloads[0].Asset, true); err != nil {
+			return
+		}
+
+		builder.readZetherRingConfiguration(txData.Payloads[0])
+		txData.Payloads[0].Data = builder.readData()
+		txData.Payloads[0].Fee = builder.readZetherFee(txData.Payloads[0].Asset)
+		propagate := gui.GUI.OutputReadBool("Propagate? y/n. Leave empty for yes", true, true)
+
+		tx, err := builder.CreateZetherTx(txData, nil, propagate, true, true, false, ctx, func(status string) {
+			gui.GUI.OutputWrite(status)
+		})
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Tx created: %s %s", base64.StdEncoding.EncodeToString(tx.Bloom.Hash), cmd))
+
+		return
+	}
+
+	cliPrivateAssetAdmin := func(cmd string, ctx context.Context) (err error) {
+		builder.showWarningIfNotSyncCLI()
+
+		extra := &wizard.WizardZetherPayloadExtraAssetAdmin{}
+		txData := &TxBuilderCreateZetherTxData{
+			Payloads: []*TxBuilderCreateZetherTxPayload{{
+				Extra: extra,
+				Asset: config_coins.NATIVE_ASSET_FULL,
+			}},
+		}
+
+		if _, txData.Payloads[0].Sender, _, err = builder.wallet.CliSelectAddress("Select Address which will update the asset", ctx); err != nil {
+			return
+		}
+
+		extra.AssetId = builder.readAsset("Asset", false)
+
+		extra.AssetUpdatePrivateKey = gui.GUI.OutputReadBytes("Asset Update Private Key", func(value []byte) bool {
+			return len(value) == cryptography.PrivateKeySize
+		})
+
+		extra.Paused = gui.GUI.OutputReadBool("Pause asset transactions? y/n. Use n to resume", false, false)
+		extra.Freeze = gui.GUI.OutputReadBool("Freeze asset minting permanently? y/n. Leave empty for no", true, false)
+
+		if _, txData.Payloads[0].Recipient, txData.Payloads[0].Amount, err = builder.readAddressOptional("Transfer Address", config_coins.NATIVE_ASSET_FULL, true); err != nil {
+			return
+		}
+
+		builder.readZetherRingConfiguration(txData.Payloads[0])
+		txData.Payloads[0].Data = builder.readData()
+		txData.Payloads[0].Fee = builder.readZetherFee(config_coins.NATIVE_ASSET_FULL)
+		propagate := gui.GUI.OutputReadBool("Propagate? y/n. Leave empty for yes", true, true)
+
+		tx, err := builder.CreateZetherTx(txData, nil, propagate, true, true, false, ctx, func(status string) {
+			gui.GUI.OutputWrite(status)
+		})
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Tx created: %s %s", base64.StdEncoding.EncodeToString(tx.Bloom.Hash), cmd))
+
+		return
+	}
+
+	cliPrivateAssetUpdate := func(cmd string, ctx context.Context) (err error) {
+		builder.showWarningIfNotSyncCLI()
+
+		extra := &wizard.WizardZetherPayloadExtraAssetUpdate{}
+		txData := &TxBuilderCreateZetherTxData{
+			Payloads: []*TxBuilderCreateZetherTxPayload{{
+				Extra: extra,
+				Asset: config_coins.NATIVE_ASSET_FULL,
+			}},
+		}
+
+		if _, txData.Payloads[0].Sender, _, err = builder.wallet.CliSelectAddress("Select Address which will update the asset", ctx); err != nil {
+			return
+		}
+
+		extra.AssetId = builder.readAsset("Asset", false)
+
+		extra.AssetUpdatePrivateKey = gui.GUI.OutputReadBytes("Asset Update Private Key", func(value []byte) bool {
+			return len(value) == cryptography.PrivateKeySize
+		})
+
+		extra.UpdateInfo = gui.GUI.OutputReadBool("Update Description and Data? y/n. Leave empty for no", true, false)
+		if extra.UpdateInfo {
+			extra.Description = gui.GUI.OutputReadString("New Description")
+			extra.Data = []byte(gui.GUI.OutputReadString("New Data. Leave empty for none"))
+		}
+
+		var updatePrivKey, supplyPrivKey *addresses.PrivateKey
+		if gui.GUI.OutputReadBool("Generate new Update Key? y/n. Leave empty for no", true, false) {
+			updatePrivKey = addresses.GenerateNewPrivateKey()
+			extra.NewUpdatePublicKey = updatePrivKey.GeneratePublicKey()
+		}
+		if gui.GUI.OutputReadBool("Generate new Supply Key? y/n. Leave empty for no", true, false) {
+			supplyPrivKey = addresses.GenerateNewPrivateKey()
+			extra.NewSupplyPublicKey = supplyPrivKey.GeneratePublicKey()
+		}
+
+		if _, txData.Payloads[0].Recipient, txData.Payloads[0].Amount, err = builder.readAddressOptional("Transfer Address", config_coins.NATIVE_ASSET_FULL, true); err != nil {
+			return
+		}
+
+		builder.readZetherRingConfiguration(txData.Payloads[0])
+		txData.Payloads[0].Data = builder.readData()
+		txData.Payloads[0].Fee = builder.readZetherFee(config_coins.NATIVE_ASSET_FULL)
+		propagate := gui.GUI.OutputReadBool("Propagate? y/n. Leave empty for yes", true, true)
+
+		tx, err := builder.CreateZetherTx(txData, nil, propagate, true, true, false, ctx, func(status string) {
+			gui.GUI.OutputWrite(status)
+		})
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("Tx created: %s %s", base64.StdEncoding.EncodeToString(tx.Bloom.Hash), cmd))
+
+		if updatePrivKey != nil || supplyPrivKey != nil {
+
+			lines := []string{fmt.Sprintf("Asset ID: %s", base64.StdEncoding.EncodeToString(extra.AssetId))}
+			if supplyPrivKey != nil {
+				lines = append(lines, fmt.Sprintf("Supply Private Key: %s", base64.StdEncoding.EncodeToString(supplyPrivKey.Key)))
+			}
+			if updatePrivKey != nil {
+				lines = append(lines, fmt.Sprintf("Update Private Key: %s", base64.StdEncoding.EncodeToString(updatePrivKey.Key)))
+			}
+
+			if filename := gui.GUI.OutputReadFilename("Path to export Asset Private Keys", "keys", true); len(filename) > 0 {
+				if err = files.WriteFile(filename, lines...); err != nil {
+					return
+				}
+				gui.GUI.OutputWrite("Asset Keys Exported successfully to: ", filename)
+			}
+
+		}
+
+		return
+	}
+
 	cliPrivatePlainAccountFund := func(cmd string, ctx context.Context) (err error) {
 		builder.showWarningIfNotSyncCLI()
 
@@ -489,7 +993,7 @@ func (builder *TxsBuilderType) initCLI() {
 		}
 
 		var addr *addresses.Address
-		if addr, err = builder.readAddress("Collector address. Leave empty for no new address", true); err != nil {
+		if addr, err = builder.readAddress("Collector address", true); err != nil {
 			return
 		}
 		if addr != nil {
@@ -601,8 +1105,17 @@ func (builder *TxsBuilderType) initCLI() {
 	}
 
 	gui.GUI.CommandDefineCallback("Private Transfer", cliPrivateTransfer, true)
+	gui.GUI.CommandDefineCallback("Private Transfer Batch", cliPrivateTransferBatch, true)
+	gui.GUI.CommandDefineCallback("Private Transfer Offline Request", cliPrivateTransferOfflineRequest, true)
+	gui.GUI.CommandDefineCallback("Sign Offline Transaction Request", cliSignOfflineRequest, true)
+	gui.GUI.CommandDefineCallback("Broadcast Signed Transaction", cliBroadcastSignedTx, true)
+	gui.GUI.CommandDefineCallback("Private Delegate Stake", cliPrivateDelegateStake, true)
+	gui.GUI.CommandDefineCallback("Private Claim", cliPrivateClaim, true)
 	gui.GUI.CommandDefineCallback("Private Asset Create", cliPrivateAssetCreate, true)
 	gui.GUI.CommandDefineCallback("Private Asset Supply Increase", cliPrivateAssetSupplyIncrease, true)
+	gui.GUI.CommandDefineCallback("Private Asset Supply Decrease", cliPrivateAssetSupplyDecrease, true)
+	gui.GUI.CommandDefineCallback("Private Asset Admin", cliPrivateAssetAdmin, true)
+	gui.GUI.CommandDefineCallback("Private Asset Update", cliPrivateAssetUpdate, true)
 	gui.GUI.CommandDefineCallback("Private Plain Account Fund", cliPrivatePlainAccountFund, true)
 	gui.GUI.CommandDefineCallback("Private Conditional Payment", cliPrivateConditionalPayment, true)
 	gui.GUI.CommandDefineCallback("Public Update Asset Fee Liquidity", cliUpdateAssetFeeLiquidity, true)
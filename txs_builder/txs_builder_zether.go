@@ -18,10 +18,12 @@ import (
 	"pandora-pay/blockchain/data_storage/registrations"
 	"pandora-pay/blockchain/data_storage/registrations/registration"
 	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/blockchain/transactions/transaction/transaction_type"
 	"pandora-pay/config/config_coins"
 	"pandora-pay/cryptography/bn256"
 	"pandora-pay/cryptography/crypto"
 	"pandora-pay/gui"
+	"pandora-pay/helpers"
 	"pandora-pay/network/websocks/connection/advanced_connection_types"
 	"pandora-pay/store"
 	"pandora-pay/store/store_db/store_db_interface"
@@ -268,7 +270,8 @@ func (builder *TxsBuilderType) createZetherRing(allAlreadyUsed map[string]bool,
 	return
 }
 
-func (builder *TxsBuilderType) prebuild(txData *TxBuilderCreateZetherTxData, pendingTxs []*transaction.Transaction, blockHeight uint64, prevKernelHash []byte, ctx context.Context, statusCallback func(string)) ([]*wizard.WizardZetherTransfer, map[string]map[string][]byte, map[string]bool, [][]*bn256.G1, [][]*bn256.G1, map[string]*wizard.WizardZetherPublicKeyIndex, uint64, []byte, error) {
+// prebuild selects the rings and reads the balances. Offline doesn't require the spend private keys as the tx will be signed by an offline wallet
+func (builder *TxsBuilderType) prebuild(txData *TxBuilderCreateZetherTxData, pendingTxs []*transaction.Transaction, blockHeight uint64, prevKernelHash []byte, offline bool, ctx context.Context, statusCallback func(string)) ([]*wizard.WizardZetherTransfer, map[string]map[string][]byte, map[string]bool, [][]*bn256.G1, [][]*bn256.G1, map[string]*wizard.WizardZetherPublicKeyIndex, uint64, []byte, error) {
 
 	sendersPrivateKeys := make([]*addresses.PrivateKey, len(txData.Payloads))
 	sendersWalletAddresses := make([]*wallet_address.WalletAddress, len(txData.Payloads))
@@ -290,6 +293,7 @@ func (builder *TxsBuilderType) prebuild(txData *TxBuilderCreateZetherTxData, pen
 		if payload.Fee == nil {
 			payload.Fee = &wizard.WizardZetherTransactionFee{&wizard.WizardTransactionFee{0, 0, 0, true}, false, 0, 0}
 		}
+		builder.setAutoFee(payload.Fee.WizardTransactionFee, transaction_type.TX_ZETHER)
 
 		sendAssets[t] = payload.Asset
 		if payload.Sender == "" {
@@ -311,6 +315,12 @@ func (builder *TxsBuilderType) prebuild(txData *TxBuilderCreateZetherTxData, pen
 			if addr.PrivateKey == nil {
 				return nil, nil, nil, nil, nil, nil, 0, nil, errors.New("Can't be used for transactions as the private key is missing")
 			}
+			//the offline requests use the key only to decrypt the balances, the txs are signed by the offline wallet
+			if !offline {
+				if err = addr.CheckCanSign(); err != nil {
+					return nil, nil, nil, nil, nil, nil, 0, nil, err
+				}
+			}
 
 			if sendersPrivateKeys[t], err = addresses.NewPrivateKey(addr.PrivateKey.Key); err != nil {
 				return nil, nil, nil, nil, nil, nil, 0, nil, err
@@ -527,13 +537,15 @@ func (builder *TxsBuilderType) prebuild(txData *TxBuilderCreateZetherTxData, pen
 				if sender {
 					if reg != nil && len(reg.SpendPublicKey) > 0 && payload.Extra == nil {
 						transfers[t].SenderSpendRequired = true
-						if sendersWalletAddresses[t].SpendPrivateKey == nil {
-							return errors.New("Spend Private Key is missing")
-						}
 						if !bytes.Equal(sendersWalletAddresses[t].SpendPublicKey, reg.SpendPublicKey) {
 							return errors.New("Wallet Spend Public Key is not matching")
 						}
-						transfers[t].SenderSpendPrivateKey = sendersWalletAddresses[t].SpendPrivateKey.Key
+						if !offline {
+							if sendersWalletAddresses[t].SpendPrivateKey == nil {
+								return errors.New("Spend Private Key is missing")
+							}
+							transfers[t].SenderSpendPrivateKey = sendersWalletAddresses[t].SpendPrivateKey.Key
+						}
 					}
 				}
 
@@ -568,49 +580,78 @@ func (builder *TxsBuilderType) prebuild(txData *TxBuilderCreateZetherTxData, pen
 	}
 	statusCallback("Balances checked")
 
+	if err := computeSendersBalances(transfers, txData.Payloads, sendersWalletAddresses, sendersEncryptedBalances, func(t int) (uint64, error) {
+		// the decrypted balance can be specified to avoid getting stuck
+		return builder.wallet.DecryptBalance(sendersWalletAddresses[t], sendersEncryptedBalances[t], transfers[t].Asset, txData.Payloads[t].DecryptedBalance > 0, txData.Payloads[t].DecryptedBalance, true, ctx, statusCallback)
+	}); err != nil {
+		return nil, nil, nil, nil, nil, nil, 0, nil, err
+	}
+
+	statusCallback("Balances decoded")
+
+	return transfers, emap, hasRollovers, ringsSenderMembers, ringsRecipientMembers, publicKeyIndexes, chainHeight, chainKernelHash, nil
+}
+
+// computeSendersBalances decrypts the balance of the first payload of every sender and asset. The encrypted balance is loaded only for the first payload,
+// so the next payloads of the same sender and asset reuse the decrypted balance minus the amounts of the previous payloads and get verified as well
+func computeSendersBalances(transfers []*wizard.WizardZetherTransfer, payloads []*TxBuilderCreateZetherTxPayload, sendersWalletAddresses []*wallet_address.WalletAddress, sendersEncryptedBalances [][]byte, decrypt func(t int) (uint64, error)) (err error) {
+
+	remaining := make(map[string]uint64)
+
 	for t := range transfers {
 
-		verify := true
+		key := string(transfers[t].SenderPrivateKey) + string(transfers[t].Asset)
+		balance, found := remaining[key]
 
 		if sendersWalletAddresses[t] == nil {
 			transfers[t].SenderDecryptedBalance = transfers[t].Amount
+			continue
+		} else if found {
+			transfers[t].SenderDecryptedBalance = balance
 		} else if sendersEncryptedBalances[t] != nil {
-
-			if txData.Payloads[t].DecryptedBalance > 0 { // in case it was specified to avoid getting stuck
-				decrypted, err := builder.wallet.DecryptBalance(sendersWalletAddresses[t], sendersEncryptedBalances[t], transfers[t].Asset, true, txData.Payloads[t].DecryptedBalance, true, ctx, statusCallback)
-				if err != nil {
-					return nil, nil, nil, nil, nil, nil, 0, nil, err
-				}
-				transfers[t].SenderDecryptedBalance = decrypted
-			} else {
-				decrypted, err := builder.wallet.DecryptBalance(sendersWalletAddresses[t], sendersEncryptedBalances[t], transfers[t].Asset, false, 0, true, ctx, statusCallback)
-				if err != nil {
-					return nil, nil, nil, nil, nil, nil, 0, nil, err
-				}
-				transfers[t].SenderDecryptedBalance = decrypted
+			if balance, err = decrypt(t); err != nil {
+				return
 			}
+			if balance == 0 {
+				return errors.New("You have no funds")
+			}
+			transfers[t].SenderDecryptedBalance = balance
 		} else {
-			verify = false
+			continue
 		}
 
-		if verify {
-			if transfers[t].SenderDecryptedBalance == 0 {
-				return nil, nil, nil, nil, nil, nil, 0, nil, errors.New("You have no funds")
-			}
-
-			if transfers[t].SenderDecryptedBalance < txData.Payloads[t].Amount {
-				return nil, nil, nil, nil, nil, nil, 0, nil, errors.New("Not enough funds")
-			}
+		spent := payloads[t].Amount
+		if err = helpers.SafeUint64Add(&spent, payloads[t].Burn); err != nil {
+			return
 		}
+		if balance < spent {
+			return errors.New("Not enough funds")
+		}
+		remaining[key] = balance - spent
 	}
 
-	statusCallback("Balances decoded")
-
-	return transfers, emap, hasRollovers, ringsSenderMembers, ringsRecipientMembers, publicKeyIndexes, chainHeight, chainKernelHash, nil
+	return
 }
 
 func (builder *TxsBuilderType) CreateZetherTx(txData *TxBuilderCreateZetherTxData, pendingTxs []*transaction.Transaction, propagateTx, awaitAnswer, awaitBroadcast bool, validateTx bool, ctx context.Context, statusCallback func(string)) (*transaction.Transaction, error) {
 
+	tx, chainHeight, err := builder.createZetherTx(txData, pendingTxs, ctx, statusCallback)
+	if err != nil {
+		return nil, err
+	}
+
+	if propagateTx {
+		if err = builder.mempool.AddTxToMempool(tx, chainHeight, true, awaitAnswer, awaitBroadcast, advanced_connection_types.UUID_ALL, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
+
+// createZetherTx creates the tx without propagating it and returns the chain height used
+func (builder *TxsBuilderType) createZetherTx(txData *TxBuilderCreateZetherTxData, pendingTxs []*transaction.Transaction, ctx context.Context, statusCallback func(string)) (*transaction.Transaction, uint64, error) {
+
 	if pendingTxs == nil {
 		pendingTxs = builder.mempool.Txs.GetTxsOnlyList()
 	}
@@ -618,9 +659,9 @@ func (builder *TxsBuilderType) CreateZetherTx(txData *TxBuilderCreateZetherTxDat
 	builder.lock.Lock()
 	defer builder.lock.Unlock()
 
-	transfers, emap, hasRollovers, ringsSenderMembers, ringsRecipientMembers, publicKeyIndexes, chainHeight, chainKernelHash, err := builder.prebuild(txData, pendingTxs, 0, nil, ctx, statusCallback)
+	transfers, emap, hasRollovers, ringsSenderMembers, ringsRecipientMembers, publicKeyIndexes, chainHeight, chainKernelHash, err := builder.prebuild(txData, pendingTxs, 0, nil, false, ctx, statusCallback)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	feesFinal := make([]*wizard.WizardTransactionFee, len(txData.Payloads))
@@ -630,20 +671,14 @@ func (builder *TxsBuilderType) CreateZetherTx(txData *TxBuilderCreateZetherTxDat
 
 	var tx *transaction.Transaction
 	if tx, err = wizard.CreateZetherTx(transfers, emap, hasRollovers, ringsSenderMembers, ringsRecipientMembers, chainHeight-1, chainKernelHash, publicKeyIndexes, feesFinal, ctx, statusCallback); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if err = txs_validator.TxsValidator.MarkAsValidatedTx(tx); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	if propagateTx {
-		if err = builder.mempool.AddTxToMempool(tx, chainHeight, true, awaitAnswer, awaitBroadcast, advanced_connection_types.UUID_ALL, ctx); err != nil {
-			return nil, err
-		}
-	}
-
-	return tx, nil
+	return tx, chainHeight, nil
 }
 
 func (builder *TxsBuilderType) CreateForgingTransactions(blkComplete *block_complete.BlockComplete, forgerPublicKey []byte, decryptedBalance uint64, pendingTxs []*transaction.Transaction) (*transaction.Transaction, error) {
@@ -709,7 +744,7 @@ func (builder *TxsBuilderType) CreateForgingTransactions(blkComplete *block_comp
 		},
 	}
 
-	transfers, emap, hasRollovers, ringsSenderMembers, ringsRecipientMembers, publicKeyIndexes, _, _, err := builder.prebuild(txData, pendingTxs, blkComplete.Height, blkComplete.PrevKernelHash, context.Background(), func(string) {})
+	transfers, emap, hasRollovers, ringsSenderMembers, ringsRecipientMembers, publicKeyIndexes, _, _, err := builder.prebuild(txData, pendingTxs, blkComplete.Height, blkComplete.PrevKernelHash, false, context.Background(), func(string) {})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,246 @@
+package txs_builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"pandora-pay/addresses"
+	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/config"
+	"pandora-pay/config/config_coins"
+	"pandora-pay/helpers"
+	"pandora-pay/helpers/generics"
+	"pandora-pay/network/websocks/connection/advanced_connection_types"
+	"pandora-pay/txs_builder/wizard"
+	"strconv"
+	"strings"
+)
+
+type TxBuilderBatchTransfer struct {
+	Recipient string         `json:"recipient" msgpack:"recipient"` //address or contact name
+	Asset     helpers.Base64 `json:"asset" msgpack:"asset"`
+	Amount    uint64         `json:"amount" msgpack:"amount"`
+	Message   string         `json:"message" msgpack:"message"`
+}
+
+type TxBuilderBatchData struct {
+	Sender    string                    `json:"sender" msgpack:"sender"`
+	Transfers []*TxBuilderBatchTransfer `json:"transfers" msgpack:"transfers"`
+	RingSize  int                       `json:"ringSize" msgpack:"ringSize"` //0 or -1 for random
+}
+
+type TxBuilderBatchResult struct {
+	Recipient string         `json:"recipient" msgpack:"recipient"`
+	TxHash    helpers.Base64 `json:"txHash,omitempty" msgpack:"txHash,omitempty"`
+	Error     string         `json:"error,omitempty" msgpack:"error,omitempty"`
+}
+
+// ParseBatchTransfers reads a JSON array or a CSV with the columns recipient,asset,amount,message
+func ParseBatchTransfers(data []byte) ([]*TxBuilderBatchTransfer, error) {
+
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '[' {
+		transfers := make([]*TxBuilderBatchTransfer, 0)
+		if err := json.Unmarshal(data, &transfers); err != nil {
+			return nil, errors.New("Error unmarshaling batch transfers")
+		}
+		return transfers, nil
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]*TxBuilderBatchTransfer, 0, len(records))
+	for i, record := range records {
+
+		if i == 0 && strings.EqualFold(record[0], "recipient") { //header
+			continue
+		}
+		if len(record) < 3 || len(record) > 4 {
+			return nil, fmt.Errorf("Invalid batch row %d", i+1)
+		}
+
+		transfer := &TxBuilderBatchTransfer{Recipient: record[0]}
+		if transfer.Asset, err = base64.StdEncoding.DecodeString(record[1]); err != nil {
+			return nil, fmt.Errorf("Invalid asset at batch row %d", i+1)
+		}
+		if transfer.Amount, err = strconv.ParseUint(record[2], 10, 64); err != nil {
+			return nil, fmt.Errorf("Invalid amount at batch row %d", i+1)
+		}
+		if len(record) == 4 {
+			transfer.Message = record[3]
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
+
+func (builder *TxsBuilderType) createBatchPayload(data *TxBuilderBatchData, transfer *TxBuilderBatchTransfer) (*TxBuilderCreateZetherTxPayload, error) {
+
+	asset := transfer.Asset
+
+	recipient, err := addresses.DecodeAddr(transfer.Recipient)
+	if err != nil {
+		contact := builder.wallet.GetContact(transfer.Recipient)
+		if contact == nil {
+			return nil, errors.New("Invalid Address or Contact")
+		}
+		if recipient, err = contact.GetAddress(asset); err != nil {
+			return nil, err
+		}
+		if len(asset) == 0 {
+			asset = contact.Asset
+		}
+	}
+
+	if len(asset) == 0 {
+		asset = config_coins.NATIVE_ASSET_FULL
+	}
+	if len(asset) != config_coins.ASSET_LENGTH {
+		return nil, errors.New("Invalid Asset")
+	}
+	if transfer.Amount == 0 {
+		return nil, errors.New("Amount can not be zero")
+	}
+	if len(transfer.Message) > config.TRANSACTIONS_MAX_DATA_LENGTH {
+		return nil, errors.New("Message is too long")
+	}
+
+	payload := &TxBuilderCreateZetherTxPayload{
+		Asset:             asset,
+		Amount:            transfer.Amount,
+		Data:              &wizard.WizardTransactionData{[]byte(transfer.Message), len(transfer.Message) > 0},
+		RingConfiguration: &ZetherRingConfiguration{&ZetherSenderRingType{false, false, nil, 0}, &ZetherRecipientRingType{false, false, nil, 0}},
+	}
+	payload.Sender = data.Sender
+	payload.Recipient = recipient.EncodeAddr()
+	payload.RingSize = data.RingSize
+	if payload.RingSize == 0 {
+		payload.RingSize = -1
+	}
+
+	if err = builder.presetZetherRing(payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// CreateZetherBatchTxs splits the transfers in payloads of as few transactions as possible and reports the tx hash or the error of every transfer
+func (builder *TxsBuilderType) CreateZetherBatchTxs(data *TxBuilderBatchData, propagateTx, awaitAnswer, awaitBroadcast bool, ctx context.Context, statusCallback func(string)) ([]*TxBuilderBatchResult, []*transaction.Transaction, error) {
+
+	if len(data.Transfers) == 0 {
+		return nil, nil, errors.New("There are no transfers")
+	}
+
+	sender, err := builder.wallet.GetWalletAddressByEncodedAddress(data.Sender, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = sender.CheckCanSign(); err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]*TxBuilderBatchResult, len(data.Transfers))
+	payloads := make([]*TxBuilderCreateZetherTxPayload, 0, len(data.Transfers))
+	rows := make([]int, 0, len(data.Transfers))
+
+	for i, transfer := range data.Transfers {
+		results[i] = &TxBuilderBatchResult{Recipient: transfer.Recipient}
+
+		payload, err := builder.createBatchPayload(data, transfer)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		payloads = append(payloads, payload)
+		rows = append(rows, i)
+	}
+
+	txs := make([]*transaction.Transaction, 0)
+
+	//the size of a tx is estimated from the ring members of the previous tx of the batch and the first tx has a single payload
+	bytesPerRingMember := uint64(0)
+
+	getBatchEnd := func(start int) int {
+		if bytesPerRingMember == 0 {
+			return start + 1
+		}
+		end, size := start, uint64(0)
+		for end < len(payloads) && (end == start || size+uint64(payloads[end].RingSize)*bytesPerRingMember <= config.TRANSACTIONS_ZETHER_BATCH_MAX_SIZE) {
+			size += uint64(payloads[end].RingSize) * bytesPerRingMember
+			end++
+		}
+		return end
+	}
+
+	for start := 0; start < len(payloads); {
+
+		//the previous txs of the batch spend from the same balance
+		pendingTxs := builder.mempool.Txs.GetTxsOnlyList()
+		for _, tx := range txs {
+			if !builder.mempool.Txs.Exists(tx.Bloom.HashStr) {
+				pendingTxs = append(pendingTxs, tx)
+			}
+		}
+
+		var tx *transaction.Transaction
+		var chainHeight uint64
+
+		end := getBatchEnd(start)
+		for {
+
+			statusCallback(fmt.Sprintf("Creating batch tx with %d transfers", end-start))
+
+			if tx, chainHeight, err = builder.createZetherTx(&TxBuilderCreateZetherTxData{payloads[start:end]}, pendingTxs, ctx, statusCallback); err != nil {
+				break
+			}
+
+			ringMembers := uint64(0)
+			for _, payload := range payloads[start:end] {
+				ringMembers += uint64(payload.RingSize)
+			}
+			bytesPerRingMember = (tx.Bloom.Size + ringMembers - 1) / ringMembers
+
+			if tx.Bloom.Size <= config.TRANSACTIONS_ZETHER_BATCH_MAX_SIZE || end-start == 1 {
+				break
+			}
+
+			//the tx is too big, it is created again with fewer payloads
+			end = generics.Min(getBatchEnd(start), end-1)
+		}
+
+		if err == nil && propagateTx {
+			err = builder.mempool.AddTxToMempool(tx, chainHeight, true, awaitAnswer, awaitBroadcast, advanced_connection_types.UUID_ALL, ctx)
+		}
+
+		for _, row := range rows[start:end] {
+			if err != nil {
+				results[row].Error = err.Error()
+			} else {
+				results[row].TxHash = tx.Bloom.Hash
+			}
+		}
+		if err == nil {
+			txs = append(txs, tx)
+		}
+
+		start = end
+	}
+
+	return results, txs, nil
+}
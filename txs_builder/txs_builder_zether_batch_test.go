@@ -0,0 +1,30 @@
+package txs_builder
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pandora-pay/config/config_coins"
+	"testing"
+)
+
+func TestParseBatchTransfers(t *testing.T) {
+
+	transfers, err := ParseBatchTransfers([]byte("recipient,asset,amount,message\nalice,,100,salary\nbob,,2500\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(transfers))
+	assert.Equal(t, "alice", transfers[0].Recipient)
+	assert.Equal(t, uint64(100), transfers[0].Amount)
+	assert.Equal(t, "salary", transfers[0].Message)
+	assert.Equal(t, 0, len(transfers[1].Asset))
+	assert.Equal(t, "", transfers[1].Message)
+
+	transfers, err = ParseBatchTransfers([]byte(`[{"recipient":"alice","asset":"` + config_coins.NATIVE_ASSET_FULL_STRING_BASE64 + `","amount":7}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(transfers))
+	assert.Equal(t, config_coins.NATIVE_ASSET_FULL, []byte(transfers[0].Asset))
+
+	_, err = ParseBatchTransfers([]byte("alice,,abc"))
+	assert.Error(t, err)
+
+	_, err = ParseBatchTransfers([]byte("alice,100"))
+	assert.Error(t, err)
+}
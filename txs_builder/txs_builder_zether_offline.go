@@ -0,0 +1,223 @@
+package txs_builder
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"pandora-pay/addresses"
+	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/cryptography"
+	"pandora-pay/cryptography/bn256"
+	"pandora-pay/cryptography/crypto"
+	"pandora-pay/helpers"
+	"pandora-pay/txs_builder/wizard"
+	"pandora-pay/wallet/wallet_address"
+)
+
+// TxBuilderZetherTxRequest is an unsigned transaction prepared by an online node to be signed by an offline wallet
+type TxBuilderZetherTxRequest struct {
+	Senders               []string                                      `json:"senders" msgpack:"senders"`
+	Transfers             []*wizard.WizardZetherTransfer                `json:"transfers" msgpack:"transfers"`
+	Emap                  map[string]map[string]helpers.Base64          `json:"emap" msgpack:"emap"` //asset base64 => ring member point => encrypted balance
+	HasRollovers          map[string]bool                               `json:"hasRollovers" msgpack:"hasRollovers"`
+	RingsSenderMembers    [][]helpers.Base64                            `json:"ringsSenderMembers" msgpack:"ringsSenderMembers"`
+	RingsRecipientMembers [][]helpers.Base64                            `json:"ringsRecipientMembers" msgpack:"ringsRecipientMembers"`
+	PublicKeyIndexes      map[string]*wizard.WizardZetherPublicKeyIndex `json:"publicKeyIndexes" msgpack:"publicKeyIndexes"` //public key base64
+	Fees                  []*wizard.WizardTransactionFee                `json:"fees" msgpack:"fees"`
+	ChainHeight           uint64                                        `json:"chainHeight" msgpack:"chainHeight"`
+	ChainKernelHash       helpers.Base64                                `json:"chainKernelHash" msgpack:"chainKernelHash"`
+}
+
+func (request *TxBuilderZetherTxRequest) Validate() error {
+
+	count := len(request.Transfers)
+	if count == 0 || len(request.Senders) != count || len(request.Fees) != count || len(request.RingsSenderMembers) != count || len(request.RingsRecipientMembers) != count {
+		return errors.New("Invalid transaction request")
+	}
+	if request.ChainHeight == 0 {
+		return errors.New("Invalid transaction request chain height")
+	}
+	if len(request.ChainKernelHash) != cryptography.HashSize {
+		return errors.New("Invalid transaction request chain kernel hash")
+	}
+
+	for t, transfer := range request.Transfers {
+		if transfer == nil || transfer.PayloadExtra != nil || request.Fees[t] == nil {
+			return errors.New("Invalid transaction request")
+		}
+		if _, err := addresses.DecodeAddr(transfer.Recipient); err != nil {
+			return err
+		}
+		ringSize := len(request.RingsSenderMembers[t])
+		if ringSize == 0 || ringSize != len(request.RingsRecipientMembers[t]) || !crypto.IsPowerOf2(2*ringSize) {
+			return errors.New("Invalid transaction request ring size")
+		}
+	}
+
+	return nil
+}
+
+func encodeRings(rings [][]*bn256.G1) [][]helpers.Base64 {
+	out := make([][]helpers.Base64, len(rings))
+	for i, ring := range rings {
+		out[i] = make([]helpers.Base64, len(ring))
+		for j, point := range ring {
+			out[i][j] = point.EncodeCompressed()
+		}
+	}
+	return out
+}
+
+func decodeRings(rings [][]helpers.Base64) ([][]*bn256.G1, error) {
+	out := make([][]*bn256.G1, len(rings))
+	for i, ring := range rings {
+		out[i] = make([]*bn256.G1, len(ring))
+		for j, data := range ring {
+			out[i][j] = new(bn256.G1)
+			if err := out[i][j].DecodeCompressed(data); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+// CreateZetherTxRequest selects the rings and reads the balances without signing. The senders can be view only addresses
+func (builder *TxsBuilderType) CreateZetherTxRequest(txData *TxBuilderCreateZetherTxData, pendingTxs []*transaction.Transaction, ctx context.Context, statusCallback func(string)) (*TxBuilderZetherTxRequest, error) {
+
+	if len(txData.Payloads) == 0 {
+		return nil, errors.New("There are no payloads")
+	}
+	for _, payload := range txData.Payloads {
+		if payload.Extra != nil {
+			return nil, errors.New("Offline transaction requests support only transfers")
+		}
+		if payload.Sender == "" {
+			return nil, errors.New("Sender is missing")
+		}
+	}
+
+	if pendingTxs == nil {
+		pendingTxs = builder.mempool.Txs.GetTxsOnlyList()
+	}
+
+	builder.lock.Lock()
+	defer builder.lock.Unlock()
+
+	transfers, emap, hasRollovers, ringsSenderMembers, ringsRecipientMembers, publicKeyIndexes, chainHeight, chainKernelHash, err := builder.prebuild(txData, pendingTxs, 0, nil, true, ctx, statusCallback)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &TxBuilderZetherTxRequest{
+		Senders:               make([]string, len(transfers)),
+		Transfers:             transfers,
+		Emap:                  make(map[string]map[string]helpers.Base64),
+		HasRollovers:          hasRollovers,
+		RingsSenderMembers:    encodeRings(ringsSenderMembers),
+		RingsRecipientMembers: encodeRings(ringsRecipientMembers),
+		PublicKeyIndexes:      make(map[string]*wizard.WizardZetherPublicKeyIndex),
+		Fees:                  make([]*wizard.WizardTransactionFee, len(transfers)),
+		ChainHeight:           chainHeight,
+		ChainKernelHash:       chainKernelHash,
+	}
+
+	for t, transfer := range transfers {
+		transfer.SenderPrivateKey = nil
+		transfer.SenderSpendPrivateKey = nil
+		request.Senders[t] = txData.Payloads[t].Sender
+		request.Fees[t] = txData.Payloads[t].Fee.WizardTransactionFee
+	}
+
+	for asset, balances := range emap {
+		out := make(map[string]helpers.Base64)
+		for point, balance := range balances {
+			out[point] = balance
+		}
+		request.Emap[base64.StdEncoding.EncodeToString([]byte(asset))] = out
+	}
+
+	for publicKey, publicKeyIndex := range publicKeyIndexes {
+		request.PublicKeyIndexes[base64.StdEncoding.EncodeToString([]byte(publicKey))] = publicKeyIndex
+	}
+
+	return request, nil
+}
+
+// SignZetherTxRequest creates the zether proofs of a transaction request using the keys of the wallet
+func (builder *TxsBuilderType) SignZetherTxRequest(request *TxBuilderZetherTxRequest, ctx context.Context, statusCallback func(string)) (*transaction.Transaction, error) {
+
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	senders := make([]*wallet_address.WalletAddress, len(request.Senders))
+	for t, sender := range request.Senders {
+		addr, err := builder.wallet.GetWalletAddressByEncodedAddress(sender, true)
+		if err != nil {
+			return nil, err
+		}
+		senders[t] = addr
+	}
+
+	builder.lock.Lock()
+	defer builder.lock.Unlock()
+
+	return signZetherTxRequest(request, senders, ctx, statusCallback)
+}
+
+// signZetherTxRequest signs a validated request with the keys of the senders
+func signZetherTxRequest(request *TxBuilderZetherTxRequest, senders []*wallet_address.WalletAddress, ctx context.Context, statusCallback func(string)) (*transaction.Transaction, error) {
+
+	for t, transfer := range request.Transfers {
+
+		addr := senders[t]
+		if err := addr.CheckCanSign(); err != nil {
+			return nil, err
+		}
+		transfer.SenderPrivateKey = addr.PrivateKey.Key
+
+		if transfer.SenderSpendRequired {
+			if addr.SpendPrivateKey == nil {
+				return nil, errors.New("Spend Private Key is missing")
+			}
+			transfer.SenderSpendPrivateKey = addr.SpendPrivateKey.Key
+		}
+	}
+
+	emap := make(map[string]map[string][]byte)
+	for assetStr, balances := range request.Emap {
+		asset, err := base64.StdEncoding.DecodeString(assetStr)
+		if err != nil {
+			return nil, err
+		}
+		emap[string(asset)] = make(map[string][]byte)
+		for point, balance := range balances {
+			emap[string(asset)][point] = balance
+		}
+	}
+
+	publicKeyIndexes := make(map[string]*wizard.WizardZetherPublicKeyIndex)
+	for publicKeyStr, publicKeyIndex := range request.PublicKeyIndexes {
+		publicKey, err := base64.StdEncoding.DecodeString(publicKeyStr)
+		if err != nil {
+			return nil, err
+		}
+		publicKeyIndexes[string(publicKey)] = publicKeyIndex
+	}
+
+	ringsSenderMembers, err := decodeRings(request.RingsSenderMembers)
+	if err != nil {
+		return nil, err
+	}
+	ringsRecipientMembers, err := decodeRings(request.RingsRecipientMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.HasRollovers == nil {
+		request.HasRollovers = make(map[string]bool)
+	}
+
+	return wizard.CreateZetherTx(request.Transfers, emap, request.HasRollovers, ringsSenderMembers, ringsRecipientMembers, request.ChainHeight-1, request.ChainKernelHash, publicKeyIndexes, request.Fees, ctx, statusCallback)
+}
@@ -0,0 +1,123 @@
+package txs_builder
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"pandora-pay/addresses"
+	"pandora-pay/blockchain/transactions/transaction/transaction_zether"
+	"pandora-pay/config/config_coins"
+	"pandora-pay/cryptography"
+	"pandora-pay/cryptography/bn256"
+	"pandora-pay/cryptography/crypto"
+	"pandora-pay/helpers"
+	"pandora-pay/txs_builder/wizard"
+	"pandora-pay/wallet/wallet_address"
+	"testing"
+)
+
+func TestZetherTxRequestRings(t *testing.T) {
+
+	rings := make([][]*bn256.G1, 2)
+	for i := range rings {
+		rings[i] = make([]*bn256.G1, 4)
+		for j := range rings[i] {
+			rings[i][j] = new(bn256.G1).ScalarBaseMult(big.NewInt(int64(i*10 + j + 1)))
+		}
+	}
+
+	decoded, err := decodeRings(encodeRings(rings))
+	assert.NoError(t, err)
+	assert.Equal(t, len(rings), len(decoded))
+	for i := range rings {
+		for j := range rings[i] {
+			assert.Equal(t, rings[i][j].String(), decoded[i][j].String())
+		}
+	}
+}
+
+func TestSignZetherTxRequest(t *testing.T) {
+
+	senderPrivateKey := addresses.GenerateNewPrivateKey()
+	senderAddress, err := senderPrivateKey.GenerateAddress(false, nil, true, nil, 0, nil)
+	assert.NoError(t, err)
+
+	amount := uint64(1000)
+
+	balances := make(map[string]helpers.Base64)
+	publicKeyIndexes := make(map[string]*wizard.WizardZetherPublicKeyIndex)
+
+	addRingMember := func(addr *addresses.Address, amount uint64) *bn256.G1 {
+		point, err := addr.GetPoint()
+		assert.NoError(t, err)
+		balance := crypto.ConstructElGamal(point.G1(), crypto.ElGamal_BASE_G)
+		if amount > 0 {
+			balance = balance.Plus(new(big.Int).SetUint64(amount))
+		}
+		balances[point.G1().String()] = balance.Serialize()
+		publicKeyIndexes[base64.StdEncoding.EncodeToString(addr.PublicKey)] = &wizard.WizardZetherPublicKeyIndex{false, 0, false, nil, addr.Registration}
+		return point.G1()
+	}
+
+	newRingMember := func() *bn256.G1 {
+		addr, err := addresses.GenerateNewPrivateKey().GenerateAddress(false, nil, true, nil, 0, nil)
+		assert.NoError(t, err)
+		return addRingMember(addr, 0)
+	}
+
+	recipientAddress, err := addresses.GenerateNewPrivateKey().GenerateAddress(false, nil, true, nil, 0, nil)
+	assert.NoError(t, err)
+
+	ringsSenderMembers := [][]*bn256.G1{{addRingMember(senderAddress, amount), newRingMember()}}
+	ringsRecipientMembers := [][]*bn256.G1{{addRingMember(recipientAddress, 0), newRingMember()}}
+
+	request := &TxBuilderZetherTxRequest{
+		Senders: []string{senderAddress.EncodeAddr()},
+		Transfers: []*wizard.WizardZetherTransfer{{
+			Asset:                  config_coins.NATIVE_ASSET_FULL,
+			SenderDecryptedBalance: amount,
+			Recipient:              recipientAddress.EncodeAddr(),
+			Amount:                 300,
+			Data:                   &wizard.WizardTransactionData{[]byte{}, false},
+			WitnessIndexes:         helpers.ShuffleArray_for_Zether(4),
+		}},
+		Emap:                  map[string]map[string]helpers.Base64{config_coins.NATIVE_ASSET_FULL_STRING_BASE64: balances},
+		HasRollovers:          map[string]bool{},
+		RingsSenderMembers:    encodeRings(ringsSenderMembers),
+		RingsRecipientMembers: encodeRings(ringsRecipientMembers),
+		PublicKeyIndexes:      publicKeyIndexes,
+		Fees:                  []*wizard.WizardTransactionFee{{Fixed: 10}},
+		ChainHeight:           5,
+		ChainKernelHash:       cryptography.RandomHash(),
+	}
+
+	//the request is transported as json to the offline wallet
+	data, err := json.Marshal(request)
+	assert.NoError(t, err)
+
+	request = &TxBuilderZetherTxRequest{}
+	assert.NoError(t, json.Unmarshal(data, request))
+	assert.NoError(t, request.Validate())
+
+	senders := []*wallet_address.WalletAddress{{PrivateKey: senderPrivateKey}}
+
+	tx, err := signZetherTxRequest(request, senders, context.Background(), func(string) {})
+	assert.NoError(t, err)
+	assert.True(t, tx.VerifySignatureManually())
+
+	txBase := tx.TransactionBaseInterface.(*transaction_zether.TransactionZether)
+	assert.Equal(t, uint64(4), txBase.ChainHeight)
+	assert.Equal(t, []byte(request.ChainKernelHash), txBase.ChainKernelHash)
+	assert.Equal(t, 1, len(txBase.Payloads))
+	assert.Equal(t, config_coins.NATIVE_ASSET_FULL, txBase.Payloads[0].Asset)
+	assert.Equal(t, uint64(10), txBase.Payloads[0].Statement.Fee)
+
+	//a view only address can't sign
+	_, err = signZetherTxRequest(request, []*wallet_address.WalletAddress{{PrivateKey: senderPrivateKey, ViewOnly: true}}, context.Background(), func(string) {})
+	assert.Error(t, err)
+
+	request.ChainHeight = 0
+	assert.Error(t, request.Validate())
+}
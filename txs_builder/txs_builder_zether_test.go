@@ -0,0 +1,51 @@
+package txs_builder
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pandora-pay/config/config_coins"
+	"pandora-pay/helpers"
+	"pandora-pay/txs_builder/wizard"
+	"pandora-pay/wallet/wallet_address"
+	"testing"
+)
+
+func TestComputeSendersBalances(t *testing.T) {
+
+	privateKey := helpers.RandomBytes(32)
+
+	create := func(amounts ...uint64) ([]*wizard.WizardZetherTransfer, []*TxBuilderCreateZetherTxPayload, []*wallet_address.WalletAddress, [][]byte) {
+		transfers := make([]*wizard.WizardZetherTransfer, len(amounts))
+		payloads := make([]*TxBuilderCreateZetherTxPayload, len(amounts))
+		addresses := make([]*wallet_address.WalletAddress, len(amounts))
+		balances := make([][]byte, len(amounts))
+		for i, amount := range amounts {
+			transfers[i] = &wizard.WizardZetherTransfer{Asset: config_coins.NATIVE_ASSET_FULL, SenderPrivateKey: privateKey, Amount: amount}
+			payloads[i] = &TxBuilderCreateZetherTxPayload{Asset: config_coins.NATIVE_ASSET_FULL, Amount: amount}
+			addresses[i] = &wallet_address.WalletAddress{}
+			balances[i] = []byte{1}
+		}
+		return transfers, payloads, addresses, balances
+	}
+
+	decrypted := 0
+	decrypt := func(int) (uint64, error) {
+		decrypted++
+		return 1000, nil
+	}
+
+	//two payloads of the same sender together exceed the balance
+	transfers, payloads, addresses, balances := create(600, 500)
+	assert.Error(t, computeSendersBalances(transfers, payloads, addresses, balances, decrypt))
+
+	decrypted = 0
+	transfers, payloads, addresses, balances = create(600, 300)
+	assert.NoError(t, computeSendersBalances(transfers, payloads, addresses, balances, decrypt))
+	assert.Equal(t, 1, decrypted)
+	assert.Equal(t, uint64(1000), transfers[0].SenderDecryptedBalance)
+	assert.Equal(t, uint64(400), transfers[1].SenderDecryptedBalance)
+
+	//the burned amount is spent as well
+	transfers, payloads, addresses, balances = create(600, 300)
+	payloads[1].Burn = 200
+	assert.Error(t, computeSendersBalances(transfers, payloads, addresses, balances, decrypt))
+}
@@ -304,6 +304,49 @@ func signZetherTx(tx *transaction.Transaction, txBase *transaction_zether.Transa
 
 				spaceExtra += 1 + len(payloadExtra.ReceiverPublicKey) + 66
 
+			case *WizardZetherPayloadExtraAssetSupplyDecrease:
+				payloads[t].PayloadScript = transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE
+				if privateKeysForSign[t], err = addresses.NewPrivateKey(payloadExtra.AssetSupplyPrivateKey); err != nil {
+					return
+				}
+				payloads[t].Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetSupplyDecrease{nil,
+					privateKeysForSign[t].GeneratePublicKey(),
+					helpers.EmptyBytes(cryptography.SignatureSize),
+				}
+
+			case *WizardZetherPayloadExtraAssetAdmin:
+				payloads[t].PayloadScript = transaction_zether_payload_script.SCRIPT_ASSET_ADMIN
+				if privateKeysForSign[t], err = addresses.NewPrivateKey(payloadExtra.AssetUpdatePrivateKey); err != nil {
+					return
+				}
+				payloads[t].Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetAdmin{nil,
+					payloadExtra.AssetId,
+					payloadExtra.Paused,
+					payloadExtra.Freeze,
+					privateKeysForSign[t].GeneratePublicKey(),
+					helpers.EmptyBytes(cryptography.SignatureSize),
+				}
+
+			case *WizardZetherPayloadExtraAssetUpdate:
+				payloads[t].PayloadScript = transaction_zether_payload_script.SCRIPT_ASSET_UPDATE
+				if privateKeysForSign[t], err = addresses.NewPrivateKey(payloadExtra.AssetUpdatePrivateKey); err != nil {
+					return
+				}
+				payloads[t].Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetUpdate{nil,
+					payloadExtra.AssetId,
+					payloadExtra.UpdateInfo,
+					payloadExtra.Description,
+					payloadExtra.Data,
+					payloadExtra.NewUpdatePublicKey,
+					payloadExtra.NewSupplyPublicKey,
+					privateKeysForSign[t].GeneratePublicKey(),
+					helpers.EmptyBytes(cryptography.SignatureSize),
+				}
+
+				if payloadExtra.UpdateInfo {
+					spaceExtra += len(payloadExtra.Description) + len(payloadExtra.Data)
+				}
+
 			case *WizardZetherPayloadExtraPlainAccountFund:
 				payloads[t].PayloadScript = transaction_zether_payload_script.SCRIPT_PLAIN_ACCOUNT_FUND
 				payloads[t].Extra = &transaction_zether_payload_extra.TransactionZetherPayloadExtraPlainAccountFund{
@@ -538,6 +581,17 @@ func signZetherTx(tx *transaction.Transaction, txBase *transaction_zether.Transa
 
 		statusCallback("Homomorphic balance Decrypted")
 
+		if balance < value+fee+burn_value {
+			return errors.New("Not enough funds")
+		}
+
+		//the next payloads of the same sender and asset spend from the remaining balance
+		for _, next := range transfers[t+1:] {
+			if bytes.Equal(next.SenderPrivateKey, transfer.SenderPrivateKey) && bytes.Equal(next.Asset, transfer.Asset) {
+				next.SenderDecryptedBalance = balance - value - fee - burn_value
+			}
+		}
+
 		// time for bullets-sigma
 		statement := GenerateStatement(CLn, CRn, publickeylist, C, &D, fee) // generate statement
 
@@ -646,6 +700,12 @@ func signZetherTx(tx *transaction.Transaction, txBase *transaction_zether.Transa
 			switch txBase.Payloads[t].PayloadScript {
 			case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_INCREASE:
 				txBase.Payloads[t].Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetSupplyIncrease).AssetSignature = signature
+			case transaction_zether_payload_script.SCRIPT_ASSET_SUPPLY_DECREASE:
+				txBase.Payloads[t].Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetSupplyDecrease).AssetSignature = signature
+			case transaction_zether_payload_script.SCRIPT_ASSET_ADMIN:
+				txBase.Payloads[t].Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetAdmin).AssetSignature = signature
+			case transaction_zether_payload_script.SCRIPT_ASSET_UPDATE:
+				txBase.Payloads[t].Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraAssetUpdate).AssetSignature = signature
 			case transaction_zether_payload_script.SCRIPT_SPEND:
 				txBase.Payloads[t].Extra.(*transaction_zether_payload_extra.TransactionZetherPayloadExtraSpend).SenderSpendSignature = signature
 			}
@@ -30,6 +30,30 @@ type WizardZetherPayloadExtraAssetSupplyIncrease struct {
 	AssetSupplyPrivateKey    []byte `json:"assetSupplyPublicKey" msgpack:"assetSupplyPublicKey"`
 }
 
+type WizardZetherPayloadExtraAssetSupplyDecrease struct {
+	WizardZetherPayloadExtra `json:"-" msgpack:""`
+	AssetSupplyPrivateKey    []byte `json:"assetSupplyPrivateKey" msgpack:"assetSupplyPrivateKey"`
+}
+
+type WizardZetherPayloadExtraAssetAdmin struct {
+	WizardZetherPayloadExtra `json:"-" msgpack:""`
+	AssetId                  []byte `json:"assetId" msgpack:"assetId"`
+	Paused                   bool   `json:"paused" msgpack:"paused"`
+	Freeze                   bool   `json:"freeze" msgpack:"freeze"`
+	AssetUpdatePrivateKey    []byte `json:"assetUpdatePrivateKey" msgpack:"assetUpdatePrivateKey"`
+}
+
+type WizardZetherPayloadExtraAssetUpdate struct {
+	WizardZetherPayloadExtra `json:"-" msgpack:""`
+	AssetId                  []byte `json:"assetId" msgpack:"assetId"`
+	UpdateInfo               bool   `json:"updateInfo" msgpack:"updateInfo"`
+	Description              string `json:"description" msgpack:"description"`
+	Data                     []byte `json:"data" msgpack:"data"`
+	NewUpdatePublicKey       []byte `json:"newUpdatePublicKey" msgpack:"newUpdatePublicKey"`
+	NewSupplyPublicKey       []byte `json:"newSupplyPublicKey" msgpack:"newSupplyPublicKey"`
+	AssetUpdatePrivateKey    []byte `json:"assetUpdatePrivateKey" msgpack:"assetUpdatePrivateKey"`
+}
+
 type WizardZetherPayloadExtraPlainAccountFund struct {
 	WizardZetherPayloadExtra `json:"-" msgpack:""`
 	PlainAccountPublicKey    []byte `json:"plainAccountPublicKey" msgpack:"plainAccountPublicKey"`
@@ -22,6 +22,7 @@ type Wallet struct {
 	Addresses               []*wallet_address.WalletAddress `json:"addresses" msgpack:"addresses"`
 	Loaded                  bool                            `json:"loaded" msgpack:"loaded"`
 	DelegatesCount          int                             `json:"delegatesCount" msgpack:"delegatesCount"`
+	AddressBook             []*WalletContact                `json:"addressBook" msgpack:"addressBook"`
 	addressesMap            map[string]*wallet_address.WalletAddress
 	forging                 *forging.Forging
 	mempool                 *mempool.Mempool
@@ -52,6 +53,7 @@ func (wallet *Wallet) clearWallet() {
 	wallet.CountImportedIndex = 0
 	wallet.Addresses = make([]*wallet_address.WalletAddress, 0)
 	wallet.addressesMap = make(map[string]*wallet_address.WalletAddress)
+	wallet.AddressBook = make([]*WalletContact, 0)
 	wallet.Encryption = createEncryption(wallet)
 	wallet.nonHardening = false
 	wallet.setLoaded(false)
@@ -88,6 +90,8 @@ func (wallet *Wallet) InitializeWallet(updateNewChainUpdate *multicast.Multicast
 	wallet.updateNewChainUpdate = updateNewChainUpdate
 	wallet.Lock.Unlock()
 
+	wallet.processHistory()
+
 	if config.NODE_CONSENSUS == config.NODE_CONSENSUS_TYPE_FULL {
 		wallet.processRefreshWallets()
 	}
@@ -24,12 +24,25 @@ type WalletAddress struct {
 	SharedStaked               *shared_staked.WalletAddressSharedStaked `json:"sharedStaked,omitempty" msgpack:"sharedStaked,omitempty"`
 	AddressEncoded             string                                   `json:"addressEncoded" msgpack:"addressEncoded"`
 	AddressRegistrationEncoded string                                   `json:"addressRegistrationEncoded" msgpack:"addressRegistrationEncoded"`
+	ViewOnly                   bool                                     `json:"viewOnly" msgpack:"viewOnly"` //only the key to decrypt the balances, it can't be used to create transactions
+}
+
+// CheckCanSign verifies that the private key can be used for signing. The key of a view only address is the same secret used for signing,
+// because the balances can't be decrypted without it, so every signing path has to refuse it: the txs builders, the offline signing, the staking key and the signed messages
+func (addr *WalletAddress) CheckCanSign() error {
+	if addr.PrivateKey == nil {
+		return errors.New("Private Key is missing")
+	}
+	if addr.ViewOnly {
+		return errors.New("View only address can't be used for signing")
+	}
+	return nil
 }
 
 func (addr *WalletAddress) DeriveSharedStaked() (*shared_staked.WalletAddressSharedStaked, error) {
 
-	if addr.PrivateKey == nil {
-		return nil, errors.New("Private Key is missing")
+	if err := addr.CheckCanSign(); err != nil {
+		return nil, err
 	}
 
 	return &shared_staked.WalletAddressSharedStaked{
@@ -54,8 +67,8 @@ func (addr *WalletAddress) DecryptMessage(message []byte) ([]byte, error) {
 }
 
 func (addr *WalletAddress) SignMessage(message []byte) ([]byte, error) {
-	if addr.PrivateKey == nil {
-		return nil, errors.New("Private Key is missing")
+	if err := addr.CheckCanSign(); err != nil {
+		return nil, err
 	}
 	return addr.PrivateKey.Sign(message)
 }
@@ -97,5 +110,6 @@ func (addr *WalletAddress) Clone() *WalletAddress {
 		sharedStaked,
 		addr.AddressEncoded,
 		addr.AddressRegistrationEncoded,
+		addr.ViewOnly,
 	}
 }
@@ -0,0 +1,39 @@
+package wallet_address
+
+import (
+	"errors"
+	"pandora-pay/helpers"
+)
+
+// WalletAddressViewKeyExported has the key to decrypt the balances and the received amounts.
+// Zether decrypts the balances with the same secret used for signing, so the key is the private key of the address and not a view only key. It is exported only for addresses that require a spend key:
+// the zether transfers need the spend key signature, but the staking proofs and the simple txs of the plain account can be signed with this key by whoever has it.
+// The wallet refuses to sign with the imported addresses, see WalletAddress.CheckCanSign
+type WalletAddressViewKeyExported struct {
+	Name           string         `json:"name" msgpack:"name"`
+	Key            helpers.Base64 `json:"key" msgpack:"key"`
+	Staked         bool           `json:"staked" msgpack:"staked"`
+	SpendPublicKey helpers.Base64 `json:"spendPublicKey" msgpack:"spendPublicKey"`
+	Warning        string         `json:"warning" msgpack:"warning"`
+}
+
+const VIEW_KEY_WARNING = "The key is the private key of the address. Whoever has it can stake with the address and sign the transactions of its plain account. Share it only with trusted parties"
+
+func (addr *WalletAddress) ExportViewKey() (*WalletAddressViewKeyExported, error) {
+
+	if addr.PrivateKey == nil {
+		return nil, errors.New("Private Key is missing")
+	}
+
+	if !addr.SpendRequired || len(addr.SpendPublicKey) == 0 {
+		return nil, errors.New("View keys can be exported only for addresses that require a spend key")
+	}
+
+	return &WalletAddressViewKeyExported{
+		Name:           addr.Name,
+		Key:            addr.PrivateKey.Key,
+		Staked:         addr.Staked,
+		SpendPublicKey: addr.SpendPublicKey,
+		Warning:        VIEW_KEY_WARNING,
+	}, nil
+}
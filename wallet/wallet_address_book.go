@@ -0,0 +1,138 @@
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"pandora-pay/addresses"
+	"pandora-pay/config/config_coins"
+	"pandora-pay/helpers"
+)
+
+type WalletContact struct {
+	Name      string         `json:"name" msgpack:"name"`
+	Address   string         `json:"address" msgpack:"address"`
+	Asset     helpers.Base64 `json:"asset,omitempty" msgpack:"asset,omitempty"`
+	PaymentID helpers.Base64 `json:"paymentID,omitempty" msgpack:"paymentID,omitempty"`
+}
+
+// GetAddress returns the decoded address of the contact with the default asset and paymentID integrated.
+// The default asset is integrated only when the payment uses no specific asset or the same one
+func (contact *WalletContact) GetAddress(asset []byte) (*addresses.Address, error) {
+
+	addr, err := addresses.DecodeAddr(contact.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contact.PaymentID) > 0 && len(addr.PaymentID) == 0 {
+		addr.PaymentID = contact.PaymentID
+	}
+	if len(contact.Asset) > 0 && len(addr.PaymentAsset) == 0 && (len(asset) == 0 || bytes.Equal(contact.Asset, asset)) {
+		addr.PaymentAsset = contact.Asset
+	}
+
+	return addr, nil
+}
+
+func (contact *WalletContact) validate() error {
+
+	if len(contact.Name) == 0 {
+		return errors.New("Contact name is empty")
+	}
+	if len(contact.Asset) != 0 && len(contact.Asset) != config_coins.ASSET_LENGTH {
+		return errors.New("Invalid Contact Asset size")
+	}
+	if len(contact.PaymentID) != 0 && len(contact.PaymentID) != 8 {
+		return errors.New("Invalid Contact PaymentID. It must be an 8 byte")
+	}
+	if _, err := addresses.DecodeAddr(contact.Name); err == nil {
+		return errors.New("Contact name can not be an address")
+	}
+
+	addr, err := addresses.DecodeAddr(contact.Address)
+	if err != nil {
+		return err
+	}
+	if len(contact.Asset) > 0 && len(addr.PaymentAsset) > 0 {
+		return errors.New("Address has already an asset integrated")
+	}
+	if len(contact.PaymentID) > 0 && len(addr.PaymentID) > 0 {
+		return errors.New("Address has already a paymentID integrated")
+	}
+
+	return nil
+}
+
+func (wallet *Wallet) getContactIndex(name string) int {
+	for i, contact := range wallet.AddressBook {
+		if contact.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (wallet *Wallet) AddContact(contact *WalletContact) error {
+
+	if err := contact.validate(); err != nil {
+		return err
+	}
+
+	wallet.Lock.Lock()
+	defer wallet.Lock.Unlock()
+
+	if !wallet.Loaded {
+		return errors.New("Wallet was not loaded!")
+	}
+
+	if wallet.getContactIndex(contact.Name) != -1 {
+		return errors.New("Contact already exists")
+	}
+
+	wallet.AddressBook = append(wallet.AddressBook, contact)
+
+	return wallet.saveWallet(0, 0, -1, false)
+}
+
+func (wallet *Wallet) RemoveContact(name string) (bool, error) {
+
+	wallet.Lock.Lock()
+	defer wallet.Lock.Unlock()
+
+	if !wallet.Loaded {
+		return false, errors.New("Wallet was not loaded!")
+	}
+
+	index := wallet.getContactIndex(name)
+	if index == -1 {
+		return false, nil
+	}
+
+	wallet.AddressBook = append(wallet.AddressBook[:index], wallet.AddressBook[index+1:]...)
+
+	return true, wallet.saveWallet(0, 0, -1, false)
+}
+
+func (wallet *Wallet) GetContacts() []*WalletContact {
+
+	wallet.Lock.RLock()
+	defer wallet.Lock.RUnlock()
+
+	out := make([]*WalletContact, len(wallet.AddressBook))
+	for i, contact := range wallet.AddressBook {
+		out[i] = &WalletContact{contact.Name, contact.Address, contact.Asset, contact.PaymentID}
+	}
+	return out
+}
+
+func (wallet *Wallet) GetContact(name string) *WalletContact {
+
+	wallet.Lock.RLock()
+	defer wallet.Lock.RUnlock()
+
+	if index := wallet.getContactIndex(name); index != -1 {
+		contact := wallet.AddressBook[index]
+		return &WalletContact{contact.Name, contact.Address, contact.Asset, contact.PaymentID}
+	}
+	return nil
+}
@@ -75,6 +75,7 @@ func (wallet *Wallet) CliListAddresses(cmd string, ctx context.Context) (err err
 		name                    string
 		addressString           string
 		addressRegisteredString string
+		viewOnly                bool
 	}
 
 	wallet.Lock.RLock()
@@ -88,7 +89,7 @@ func (wallet *Wallet) CliListAddresses(cmd string, ctx context.Context) (err err
 	addresses := make([]*Address, len(wallet.Addresses))
 
 	for i, walletAddress := range wallet.Addresses {
-		addresses[i] = &Address{publicKey: helpers.CloneBytes(walletAddress.PublicKey), name: walletAddress.Name, addressString: walletAddress.GetAddress(false), addressRegisteredString: walletAddress.GetAddress(true)}
+		addresses[i] = &Address{publicKey: helpers.CloneBytes(walletAddress.PublicKey), name: walletAddress.Name, addressString: walletAddress.GetAddress(false), addressRegisteredString: walletAddress.GetAddress(true), viewOnly: walletAddress.ViewOnly}
 	}
 	wallet.Lock.RUnlock()
 
@@ -150,10 +151,15 @@ func (wallet *Wallet) CliListAddresses(cmd string, ctx context.Context) (err err
 	var decrypted uint64
 	for i, address := range addresses {
 
+		name := address.name
+		if address.viewOnly {
+			name += " [VIEW ONLY]"
+		}
+
 		if addresses[i].registration != nil {
-			gui.GUI.OutputWrite(fmt.Sprintf("%d) %s :: %s", i, address.name, address.addressRegisteredString))
+			gui.GUI.OutputWrite(fmt.Sprintf("%d) %s :: %s", i, name, address.addressRegisteredString))
 		} else {
-			gui.GUI.OutputWrite(fmt.Sprintf("%d) %s :: %s", i, address.name, address.addressString))
+			gui.GUI.OutputWrite(fmt.Sprintf("%d) %s :: %s", i, name, address.addressString))
 		}
 
 		if len(addresses[i].assetsList) == 0 && addresses[i].plainAcc == nil {
@@ -321,6 +327,52 @@ func (wallet *Wallet) initWalletCLI() {
 		return
 	}
 
+	cliExportViewKey := func(cmd string, ctx context.Context) (err error) {
+
+		addr, _, _, err := wallet.CliSelectAddress("Select Address to export the view key", ctx)
+		if err != nil {
+			return
+		}
+
+		viewKey, err := wallet.ExportViewKey(addr)
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite("WARNING: " + viewKey.Warning)
+
+		filename := gui.GUI.OutputReadFilename("Path to export", "pandoraview", false)
+
+		var marshal []byte
+		if marshal, err = json.Marshal(viewKey); err != nil {
+			return errors.New("Error marshaling view key")
+		}
+
+		if err = files.WriteFile(filename, string(marshal)); err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite("Exported the private key of the address successfully to: ", filename)
+		return
+	}
+
+	cliImportViewKey := func(cmd string, ctx context.Context) (err error) {
+
+		str := gui.GUI.OutputReadFilename("Path to import View Key", "pandoraview", false)
+
+		data, err := os.ReadFile(str)
+		if err != nil {
+			return
+		}
+
+		if _, err = wallet.ImportViewKey(data); err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite("Imported successfully from: ", str)
+		return
+	}
+
 	cliExportWalletJSON := func(cmd string, ctx context.Context) (err error) {
 
 		filename := gui.GUI.OutputReadFilename("Path to export", "pandorawallet", false)
@@ -507,6 +559,99 @@ func (wallet *Wallet) initWalletCLI() {
 		return
 	}
 
+	cliShowHistory := func(cmd string, ctx context.Context) (err error) {
+
+		addr, _, _, err := wallet.CliSelectAddress("Select Address to show the history", ctx)
+		if err != nil {
+			return
+		}
+
+		history, err := wallet.GetHistory(addr.PublicKey, 0, 0)
+		if err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite(fmt.Sprintf("%18s: %d", "Txs", len(history)))
+		for _, historyTx := range history {
+			gui.GUI.OutputWrite(fmt.Sprintf("%18d: %s", historyTx.BlockHeight, base64.StdEncoding.EncodeToString(historyTx.TxHash)))
+			if historyTx.Decrypted == nil || historyTx.Decrypted.ZetherTx == nil {
+				continue
+			}
+			for _, payload := range historyTx.Decrypted.ZetherTx.Payloads {
+				if payload == nil {
+					continue
+				}
+				if payload.WhisperSenderValid {
+					gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s %s", "Sent", strconv.FormatFloat(config_coins.ConvertToBase(payload.SentAmount), 'f', config_coins.DECIMAL_SEPARATOR, 64), base64.StdEncoding.EncodeToString(payload.Asset)))
+				}
+				if payload.WhisperRecipientValid {
+					gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s %s", "Received", strconv.FormatFloat(config_coins.ConvertToBase(payload.ReceivedAmount), 'f', config_coins.DECIMAL_SEPARATOR, 64), base64.StdEncoding.EncodeToString(payload.Asset)))
+				}
+				if len(payload.Message) > 0 {
+					gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s", "Message", string(payload.Message)))
+				}
+			}
+		}
+
+		return
+	}
+
+	cliListContacts := func(cmd string, ctx context.Context) (err error) {
+
+		contacts := wallet.GetContacts()
+
+		gui.GUI.OutputWrite(fmt.Sprintf("%18s: %d", "Contacts", len(contacts)))
+		for _, contact := range contacts {
+			gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s", contact.Name, contact.Address))
+			if len(contact.Asset) > 0 {
+				gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s", "Asset", base64.StdEncoding.EncodeToString(contact.Asset)))
+			}
+			if len(contact.PaymentID) > 0 {
+				gui.GUI.OutputWrite(fmt.Sprintf("%18s: %s", "PaymentID", base64.StdEncoding.EncodeToString(contact.PaymentID)))
+			}
+		}
+
+		return
+	}
+
+	cliAddContact := func(cmd string, ctx context.Context) (err error) {
+
+		contact := &WalletContact{}
+
+		contact.Name = gui.GUI.OutputReadString("Contact name")
+		contact.Address = gui.GUI.OutputReadString("Contact address")
+		contact.Asset = gui.GUI.OutputReadBytes("Default Asset. Leave empty for none", func(input []byte) bool {
+			return len(input) == 0 || len(input) == config_coins.ASSET_LENGTH
+		})
+		contact.PaymentID = gui.GUI.OutputReadBytes("PaymentID. Leave empty for none", func(input []byte) bool {
+			return len(input) == 0 || len(input) == 8
+		})
+
+		if err = wallet.AddContact(contact); err != nil {
+			return
+		}
+
+		gui.GUI.OutputWrite("Contact added")
+		return
+	}
+
+	cliRemoveContact := func(cmd string, ctx context.Context) (err error) {
+
+		name := gui.GUI.OutputReadString("Contact name to be removed")
+
+		var success bool
+		if success, err = wallet.RemoveContact(name); err != nil {
+			return
+		}
+
+		if success {
+			gui.GUI.OutputWrite("Contact removed")
+		} else {
+			gui.GUI.OutputWrite("Contact was NOT found")
+		}
+		return
+	}
+
 	cliImportAddressSecretKey := func(cmd string, ctx context.Context) (err error) {
 
 		secretKey := gui.GUI.OutputReadBytes("Write Secret key", func(input []byte) bool {
@@ -660,6 +805,7 @@ func (wallet *Wallet) initWalletCLI() {
 	}
 
 	gui.GUI.CommandDefineCallback("List Addresses", wallet.CliListAddresses, wallet.Loaded)
+	gui.GUI.CommandDefineCallback("Show History", cliShowHistory, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Create New Address", cliCreateNewAddress, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Clear & Create new empty Wallet", cliClearWallet, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Show Mnemnonic", cliShowMnemonic, wallet.Loaded)
@@ -670,9 +816,14 @@ func (wallet *Wallet) initWalletCLI() {
 	gui.GUI.CommandDefineCallback("Import Address Secret Key", cliImportAddressSecretKey, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Remove Address", cliRemoveAddress, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Export Staked Staked Address", cliExportSharedStakedAddress, wallet.Loaded)
+	gui.GUI.CommandDefineCallback("List Contacts", cliListContacts, wallet.Loaded)
+	gui.GUI.CommandDefineCallback("Add Contact", cliAddContact, wallet.Loaded)
+	gui.GUI.CommandDefineCallback("Remove Contact", cliRemoveContact, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Export Addresses", cliExportAddresses, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Export Address JSON", cliExportAddressJSON, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Import Address JSON", cliImportAddressJSON, wallet.Loaded)
+	gui.GUI.CommandDefineCallback("Export View Key", cliExportViewKey, wallet.Loaded)
+	gui.GUI.CommandDefineCallback("Import View Key", cliImportViewKey, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Export Wallet JSON", cliExportWalletJSON, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Import Wallet JSON", cliImportWalletJSON, wallet.Loaded)
 	gui.GUI.CommandDefineCallback("Encrypt Wallet", cliEncryptWallet, wallet.Loaded)
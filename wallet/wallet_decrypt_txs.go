@@ -35,6 +35,10 @@ type DecryptedTx struct {
 }
 
 func (w *Wallet) DecryptTx(tx *transaction.Transaction, walletPublicKey []byte) (*DecryptedTx, error) {
+	return w.decryptTx(tx, walletPublicKey, true)
+}
+
+func (w *Wallet) decryptTx(tx *transaction.Transaction, walletPublicKey []byte, lock bool) (*DecryptedTx, error) {
 
 	if tx == nil {
 		return nil, errors.New("Transaction is invalid")
@@ -62,7 +66,7 @@ func (w *Wallet) DecryptTx(tx *transaction.Transaction, walletPublicKey []byte)
 					continue
 				}
 
-				if addr := w.GetWalletAddressByPublicKey(publicKey, true); addr != nil {
+				if addr := w.GetWalletAddressByPublicKey(publicKey, lock); addr != nil {
 
 					decyptedZetherPayload := &DecryptZetherPayloadOutput{
 						RecipientIndex: -1,
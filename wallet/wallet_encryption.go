@@ -48,7 +48,9 @@ func (self *WalletEncryption) Encrypt(newPassword string, difficulty int) (err e
 		return
 	}
 
-	if err = self.wallet.saveWalletEntire(false); err != nil {
+	if err = self.wallet.saveWalletEntireRecryptHistory(func(input []byte) ([]byte, error) {
+		return input, nil
+	}); err != nil {
 		return
 	}
 
@@ -116,11 +118,13 @@ func (self *WalletEncryption) RemoveEncryption() (err error) {
 		return errors.New("Wallet is not encrypted!")
 	}
 
+	oldEncryptionCipher := self.encryptionCipher
+
 	self.Encrypted = ENCRYPTED_VERSION_PLAIN_TEXT
 	self.password = ""
 	self.Difficulty = 0
 
-	if err = self.wallet.saveWalletEntire(false); err != nil {
+	if err = self.wallet.saveWalletEntireRecryptHistory(oldEncryptionCipher.Decrypt); err != nil {
 		return
 	}
 
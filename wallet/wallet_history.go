@@ -0,0 +1,255 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/vmihailenco/msgpack/v5"
+	"math"
+	"pandora-pay/blockchain/blockchain_types"
+	"pandora-pay/blockchain/transactions/transaction"
+	"pandora-pay/blockchain/transactions/transaction/transaction_simple"
+	"pandora-pay/blockchain/transactions/transaction/transaction_type"
+	"pandora-pay/blockchain/transactions/transaction/transaction_zether"
+	"pandora-pay/gui"
+	"pandora-pay/helpers"
+	"pandora-pay/helpers/recovery"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
+)
+
+type WalletHistoryTx struct {
+	TxHash         helpers.Base64 `json:"txHash" msgpack:"txHash"`
+	PublicKey      helpers.Base64 `json:"publicKey" msgpack:"publicKey"`
+	BlockHeight    uint64         `json:"blockHeight" msgpack:"blockHeight"`
+	BlockTimestamp uint64         `json:"blockTimestamp" msgpack:"blockTimestamp"`
+	Decrypted      *DecryptedTx   `json:"decrypted" msgpack:"decrypted"`
+}
+
+// historyKey sorts the txs of an address starting with the most recent block
+func historyKey(publicKey []byte, blockHeight uint64, txHash []byte) string {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, math.MaxUint64-blockHeight)
+	return "history:" + string(publicKey) + string(buf) + string(txHash)
+}
+
+// getTxWalletPublicKeys is called with the wallet lock
+func (wallet *Wallet) getTxWalletPublicKeys(tx *transaction.Transaction) [][]byte {
+
+	candidates := make([][]byte, 0)
+
+	switch tx.Version {
+	case transaction_type.TX_SIMPLE:
+		txBase := tx.TransactionBaseInterface.(*transaction_simple.TransactionSimple)
+		candidates = append(candidates, txBase.Vin.PublicKey)
+	case transaction_type.TX_ZETHER:
+		txBase := tx.TransactionBaseInterface.(*transaction_zether.TransactionZether)
+		for _, publicKeyList := range txBase.Bloom.PublicKeyLists {
+			candidates = append(candidates, publicKeyList...)
+		}
+	}
+
+	visited := make(map[string]bool)
+	out := make([][]byte, 0)
+	for _, publicKey := range candidates {
+		if visited[string(publicKey)] {
+			continue
+		}
+		visited[string(publicKey)] = true
+		if wallet.GetWalletAddressByPublicKey(publicKey, false) != nil {
+			out = append(out, publicKey)
+		}
+	}
+
+	return out
+}
+
+// isWalletHistoryTx skips the decoys of the rings, so only the txs sent or received by the address are stored
+func isWalletHistoryTx(tx *transaction.Transaction, publicKey []byte, decrypted *DecryptedTx) bool {
+	switch tx.Version {
+	case transaction_type.TX_SIMPLE:
+		txBase := tx.TransactionBaseInterface.(*transaction_simple.TransactionSimple)
+		return txBase.HasVin() && bytes.Equal(txBase.Vin.PublicKey, publicKey)
+	case transaction_type.TX_ZETHER:
+		if decrypted.ZetherTx != nil {
+			for _, payload := range decrypted.ZetherTx.Payloads {
+				if payload != nil && (payload.WhisperSenderValid || payload.WhisperRecipientValid) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// processHistoryChanges stores the decrypted txs of the wallet addresses and removes the ones that were reverted by a reorg.
+// The lock is held during the entire write, so the encryption can't be changed meanwhile
+func (wallet *Wallet) processHistoryChanges(changes []*blockchain_types.BlockchainTransactionUpdate) error {
+
+	wallet.Lock.RLock()
+	defer wallet.Lock.RUnlock()
+
+	return store.StoreWallet.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		for _, change := range changes {
+
+			if !change.Inserted {
+
+				data := writer.Get("historyTx:" + change.TxHashStr)
+				if data == nil {
+					continue
+				}
+
+				keys := []string{}
+				if err = msgpack.Unmarshal(data, &keys); err != nil {
+					return
+				}
+				for _, key := range keys {
+					writer.Delete(key)
+				}
+				writer.Delete("historyTx:" + change.TxHashStr)
+
+				continue
+			}
+
+			if err = change.Tx.BloomAll(); err != nil {
+				return
+			}
+
+			publicKeys := wallet.getTxWalletPublicKeys(change.Tx)
+			if len(publicKeys) == 0 {
+				continue
+			}
+
+			keys := make([]string, 0, len(publicKeys))
+			for _, publicKey := range publicKeys {
+
+				historyTx := &WalletHistoryTx{
+					TxHash:         change.TxHash,
+					PublicKey:      publicKey,
+					BlockHeight:    change.BlockHeight,
+					BlockTimestamp: change.BlockTimestamp,
+				}
+
+				if historyTx.Decrypted, err = wallet.decryptTx(change.Tx, publicKey, false); err != nil {
+					return
+				}
+				if !isWalletHistoryTx(change.Tx, publicKey, historyTx.Decrypted) {
+					continue
+				}
+
+				var data []byte
+				if data, err = msgpack.Marshal(historyTx); err != nil {
+					return
+				}
+				if data, err = wallet.Encryption.encryptData(data); err != nil {
+					return
+				}
+
+				key := historyKey(publicKey, change.BlockHeight, change.TxHash)
+				writer.Put(key, data)
+				keys = append(keys, key)
+			}
+
+			if len(keys) == 0 {
+				continue
+			}
+
+			var data []byte
+			if data, err = msgpack.Marshal(keys); err != nil {
+				return
+			}
+			writer.Put("historyTx:"+change.TxHashStr, data)
+		}
+
+		return
+	})
+}
+
+// GetHistory returns the txs of an address starting with the most recent one. Only the returned page is decrypted
+func (wallet *Wallet) GetHistory(publicKey []byte, start, count int) ([]*WalletHistoryTx, error) {
+
+	wallet.Lock.RLock()
+	defer wallet.Lock.RUnlock()
+
+	if wallet.GetWalletAddressByPublicKey(publicKey, false) == nil {
+		return nil, errors.New("Address was not found")
+	}
+
+	list := make([]*WalletHistoryTx, 0)
+	if start < 0 {
+		return list, nil
+	}
+
+	if err := store.StoreWallet.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		index := 0
+		reader.Range("history:"+string(publicKey), func(key string, value []byte) bool {
+
+			if index < start {
+				index++
+				return true
+			}
+
+			var data []byte
+			if data, err = wallet.Encryption.decryptData(value); err != nil {
+				return false
+			}
+
+			historyTx := &WalletHistoryTx{}
+			if err = msgpack.Unmarshal(data, historyTx); err != nil {
+				return false
+			}
+
+			list = append(list, historyTx)
+			return count <= 0 || len(list) < count
+		})
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// recryptHistory encrypts again the stored history after the wallet encryption was changed. It is called with the wallet lock inside the transaction that saves the wallet
+func (wallet *Wallet) recryptHistory(writer store_db_interface.StoreDBTransactionInterface, decrypt func([]byte) ([]byte, error)) (err error) {
+
+	stored := make(map[string][]byte)
+	writer.Range("history:", func(key string, value []byte) bool {
+		stored[key] = helpers.CloneBytes(value)
+		return true
+	})
+
+	for key, value := range stored {
+		if value, err = decrypt(value); err != nil {
+			return
+		}
+		if value, err = wallet.Encryption.encryptData(value); err != nil {
+			return
+		}
+		writer.Put(key, value)
+	}
+
+	return
+}
+
+func (wallet *Wallet) processHistory() {
+	recovery.SafeGo(func() {
+
+		updateNewChainUpdateListener := wallet.updateNewChainUpdate.AddListener()
+		defer wallet.updateNewChainUpdate.RemoveChannel(updateNewChainUpdateListener)
+
+		for {
+			update, ok := <-updateNewChainUpdateListener
+			if !ok {
+				return
+			}
+
+			if err := wallet.processHistoryChanges(update.TransactionsChanges); err != nil {
+				gui.GUI.Error("Error storing the wallet history", err)
+			}
+		}
+
+	})
+}
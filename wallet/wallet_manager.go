@@ -9,10 +9,15 @@ import (
 	"github.com/tyler-smith/go-bip32"
 	"math/rand"
 	"pandora-pay/addresses"
+	"pandora-pay/blockchain/data_storage"
+	"pandora-pay/blockchain/data_storage/plain_accounts/plain_account"
+	"pandora-pay/blockchain/data_storage/registrations/registration"
 	"pandora-pay/config/config_nodes"
 	"pandora-pay/config/globals"
 	"pandora-pay/cryptography"
 	"pandora-pay/cryptography/crypto"
+	"pandora-pay/store"
+	"pandora-pay/store/store_db/store_db_interface"
 	"pandora-pay/wallet/wallet_address"
 	"pandora-pay/wallet/wallet_address/shared_staked"
 	"strconv"
@@ -236,7 +241,7 @@ func (wallet *Wallet) AddAddress(addr *wallet_address.WalletAddress, staked, spe
 	addr.Registration = addr2.Registration
 	addr.PublicKey = publicKey
 
-	if addr.PrivateKey != nil {
+	if addr.PrivateKey != nil && !addr.ViewOnly {
 		if addr.SharedStaked, err = addr.DeriveSharedStaked(); err != nil {
 			return
 		}
@@ -522,6 +527,76 @@ func (wallet *Wallet) ImportWalletAddressJSON(data []byte) (*wallet_address.Wall
 	return addr, nil
 }
 
+// ExportViewKey exports the key that decrypts the balances. The key is the private key of the address, so it is refused while the key could stake
+// with the address or spend the unclaimed funds and the fee liquidity of its plain account
+func (wallet *Wallet) ExportViewKey(addr *wallet_address.WalletAddress) (*wallet_address.WalletAddressViewKeyExported, error) {
+
+	viewKey, err := addr.ExportViewKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = store.StoreBlockchain.DB.View(func(reader store_db_interface.StoreDBTransactionInterface) (err error) {
+
+		dataStorage := data_storage.NewDataStorage(reader)
+
+		var reg *registration.Registration
+		if reg, err = dataStorage.Regs.Get(string(addr.PublicKey)); err != nil {
+			return
+		}
+		if addr.Staked || (reg != nil && reg.Staked) {
+			return errors.New("The view key can't be exported for a staked address, because it can stake with the address")
+		}
+
+		var plainAcc *plain_account.PlainAccount
+		if plainAcc, err = dataStorage.PlainAccs.Get(string(addr.PublicKey)); err != nil {
+			return
+		}
+		if plainAcc != nil && (plainAcc.Unclaimed > 0 || plainAcc.AssetFeeLiquidities.HasAssetFeeLiquidities()) {
+			return errors.New("The view key can't be exported while the plain account has unclaimed funds or fee liquidity, because it can sign the transactions of the plain account")
+		}
+
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return viewKey, nil
+}
+
+// ImportViewKey imports a view only address that can decrypt the balances, but can't create transactions
+func (wallet *Wallet) ImportViewKey(data []byte) (*wallet_address.WalletAddress, error) {
+
+	viewKey := &wallet_address.WalletAddressViewKeyExported{}
+	if err := json.Unmarshal(data, viewKey); err != nil {
+		return nil, errors.New("Error unmarshaling view key")
+	}
+
+	if len(viewKey.SpendPublicKey) != cryptography.PublicKeySize {
+		return nil, errors.New("Spend Public Key is missing")
+	}
+
+	privateKey, err := addresses.NewPrivateKey(viewKey.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := &wallet_address.WalletAddress{
+		Version:        wallet_address.VERSION_NORMAL,
+		Name:           viewKey.Name,
+		IsImported:     true,
+		PrivateKey:     privateKey,
+		SpendPublicKey: viewKey.SpendPublicKey,
+		ViewOnly:       true,
+	}
+
+	if err = wallet.AddAddress(addr, viewKey.Staked, true, true, false, false, true); err != nil {
+		return nil, err
+	}
+
+	return addr, nil
+}
+
 func (wallet *Wallet) DecryptBalance(addr *wallet_address.WalletAddress, encryptedBalance, asset []byte, useNewPreviousValue bool, newPreviousValue uint64, store bool, ctx context.Context, statusCallback func(string)) (uint64, error) {
 
 	if len(encryptedBalance) == 0 {
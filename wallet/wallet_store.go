@@ -59,42 +59,61 @@ func (wallet *Wallet) saveWallet(start, end, deleteIndex int, lock bool) error {
 		return errors.New("Can't save your wallet because your stored wallet on the drive was not successfully loaded")
 	}
 
-	return store.StoreWallet.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) (err error) {
+	return store.StoreWallet.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) error {
+		return wallet.saveWalletNow(writer, start, end, deleteIndex)
+	})
+}
 
-		var marshal []byte
+// saveWalletEntireRecryptHistory saves the wallet and encrypts again the history in the same transaction after the encryption was changed
+func (wallet *Wallet) saveWalletEntireRecryptHistory(decrypt func([]byte) ([]byte, error)) error {
 
-		writer.Put("saved", []byte{0})
+	if !wallet.Loaded {
+		return errors.New("Can't save your wallet because your stored wallet on the drive was not successfully loaded")
+	}
 
-		if marshal, err = helpers.GetMarshalledDataExcept(wallet.Encryption); err != nil {
+	return store.StoreWallet.DB.Update(func(writer store_db_interface.StoreDBTransactionInterface) (err error) {
+		if err = wallet.saveWalletNow(writer, 0, len(wallet.Addresses), -1); err != nil {
 			return
 		}
-		writer.Put("encryption", marshal)
+		return wallet.recryptHistory(writer, decrypt)
+	})
+}
 
-		if marshal, err = helpers.GetMarshalledDataExcept(wallet, "addresses", "encryption"); err != nil {
+func (wallet *Wallet) saveWalletNow(writer store_db_interface.StoreDBTransactionInterface, start, end, deleteIndex int) (err error) {
+
+	var marshal []byte
+
+	writer.Put("saved", []byte{0})
+
+	if marshal, err = helpers.GetMarshalledDataExcept(wallet.Encryption); err != nil {
+		return
+	}
+	writer.Put("encryption", marshal)
+
+	if marshal, err = helpers.GetMarshalledDataExcept(wallet, "addresses", "encryption"); err != nil {
+		return
+	}
+	if marshal, err = wallet.Encryption.encryptData(marshal); err != nil {
+		return
+	}
+
+	writer.Put("wallet", marshal)
+
+	for i := start; i < end; i++ {
+		if marshal, err = msgpack.Marshal(wallet.Addresses[i]); err != nil {
 			return
 		}
 		if marshal, err = wallet.Encryption.encryptData(marshal); err != nil {
 			return
 		}
+		writer.Put("wallet-address-"+strconv.Itoa(i), marshal)
+	}
+	if deleteIndex != -1 {
+		writer.Delete("wallet-address-" + strconv.Itoa(deleteIndex))
+	}
 
-		writer.Put("wallet", marshal)
-
-		for i := start; i < end; i++ {
-			if marshal, err = msgpack.Marshal(wallet.Addresses[i]); err != nil {
-				return
-			}
-			if marshal, err = wallet.Encryption.encryptData(marshal); err != nil {
-				return
-			}
-			writer.Put("wallet-address-"+strconv.Itoa(i), marshal)
-		}
-		if deleteIndex != -1 {
-			writer.Delete("wallet-address-" + strconv.Itoa(deleteIndex))
-		}
-
-		writer.Put("saved", []byte{1})
-		return
-	})
+	writer.Put("saved", []byte{1})
+	return
 }
 
 func (wallet *Wallet) loadWallet(password string, firstTime bool) error {